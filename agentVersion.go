@@ -0,0 +1,140 @@
+/*
+MIT License
+
+Copyright (c) 2026 Cisco Systems, Inc. and its affiliates
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+
+*/
+
+package appdrest
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// AgentVersion is a comparable agent version parsed from the free text version of a node
+type AgentVersion struct {
+	Major int
+	Minor int
+	Patch int
+	Build int
+	Raw   string
+}
+
+var (
+	agentVersionTagged = regexp.MustCompile(`(?:^|[\s#])v?(\d+)\.(\d+)(?:\.(\d+))?(?:\.(\d+))?`)
+	agentVersionPrefix = regexp.MustCompile(`\bv(\d+)\.(\d+)(?:\.(\d+))?(?:\.(\d+))?`)
+)
+
+// ParseAgentVersion parses agent version strings such as
+// "Server Agent #23.8.0.35032 v23.8.0 GA compatible with 4.4.1.0 ..." or "Machine Agent v23.7.0.3689 GA ...".
+// A "v" prefixed version wins over other numbers, "compatible with" controller versions are ignored.
+func ParseAgentVersion(s string) (AgentVersion, error) {
+	text := s
+	if i := strings.Index(strings.ToLower(text), "compatible with"); i >= 0 {
+		text = text[:i]
+	}
+
+	match := agentVersionPrefix.FindStringSubmatch(text)
+	if match == nil {
+		match = agentVersionTagged.FindStringSubmatch(text)
+	}
+	if match == nil {
+		return AgentVersion{Raw: s}, fmt.Errorf("no agent version in %q", s)
+	}
+
+	v := AgentVersion{Raw: s}
+	parts := []*int{&v.Major, &v.Minor, &v.Patch, &v.Build}
+	for i, part := range match[1:] {
+		if part != "" {
+			*parts[i], _ = strconv.Atoi(part)
+		}
+	}
+
+	// "v23.8.0" often comes without the build number that is given after "#"
+	if v.Build == 0 {
+		if tagged := agentVersionTagged.FindStringSubmatch(text); tagged != nil && tagged[4] != "" {
+			if tagged[1] == match[1] && tagged[2] == match[2] {
+				v.Build, _ = strconv.Atoi(tagged[4])
+			}
+		}
+	}
+	return v, nil
+}
+
+// MustParseAgentVersion is like ParseAgentVersion but panics on invalid versions, for constants in code
+func MustParseAgentVersion(s string) AgentVersion {
+	v, err := ParseAgentVersion(s)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// IsZero reports whether the version is unknown
+func (v AgentVersion) IsZero() bool {
+	return v.Major == 0 && v.Minor == 0 && v.Patch == 0 && v.Build == 0
+}
+
+// Compare returns -1, 0 or 1 when v is older, equal or newer than o
+func (v AgentVersion) Compare(o AgentVersion) int {
+	for _, d := range [][2]int{{v.Major, o.Major}, {v.Minor, o.Minor}, {v.Patch, o.Patch}, {v.Build, o.Build}} {
+		if d[0] < d[1] {
+			return -1
+		}
+		if d[0] > d[1] {
+			return 1
+		}
+	}
+	return 0
+}
+
+// Less reports whether v is older than o
+func (v AgentVersion) Less(o AgentVersion) bool {
+	return v.Compare(o) < 0
+}
+
+// String returns the version as major.minor.patch, with the build number when known
+func (v AgentVersion) String() string {
+	if v.Build != 0 {
+		return fmt.Sprintf("%d.%d.%d.%d", v.Major, v.Minor, v.Patch, v.Build)
+	}
+	return fmt.Sprintf("%d.%d.%d", v.Major, v.Minor, v.Patch)
+}
+
+// Release returns the release train of the version, e.g. "23.8"
+func (v AgentVersion) Release() string {
+	return fmt.Sprintf("%d.%d", v.Major, v.Minor)
+}
+
+// AppAgentSemver parses the app agent version of the node
+// Added 2026 Cisco Systems, Inc.
+func (n *Node) AppAgentSemver() (AgentVersion, error) {
+	return ParseAgentVersion(n.AppAgentVersion)
+}
+
+// MachineAgentSemver parses the machine agent version of the node
+// Added 2026 Cisco Systems, Inc.
+func (n *Node) MachineAgentSemver() (AgentVersion, error) {
+	return ParseAgentVersion(n.MachineAgentVersion)
+}
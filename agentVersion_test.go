@@ -0,0 +1,59 @@
+/*
+MIT License
+
+Copyright (c) 2026 Cisco Systems, Inc. and its affiliates
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+
+*/
+
+package appdrest
+
+import "testing"
+
+func TestParseAgentVersion(t *testing.T) {
+	tests := []struct {
+		agent string
+		raw   string
+		want  string
+	}{
+		{"Java", "Server Agent #23.8.0.35032 v23.8.0 GA compatible with 4.4.1.0 r2f7c4c2d8f1a0e4b1c9d7d5a0b3e6f8a9c1d2e3f release/23.8.0", "23.8.0.35032"},
+		{"Java 4.5", "Server Agent v4.5.17.28908 GA #4.5.17.28908 r8e2bd7a7d8b7e7e28e5b3cdd9d4eab5a85f3c36b 123-4.5.17.next-build", "4.5.17.28908"},
+		{".NET", "Server Agent v23.10.0.0 GA #23.10.0.0 r9b05d6a3b2a9c1f4e7d8c5b6a3f2e1d0c9b8a7f6 release/23.10.0", "23.10.0"},
+		{"Node.js", "Server Agent v22.3.0.0 GA #22.3.0 r4b1c2d3e Node.js v14.17.0 compatible with 4.4.1.0", "22.3.0"},
+		{"machine", "Machine Agent v23.7.0.3689 GA compatible with 4.4.1.0 Build Date 2023-07-27 10:53:48", "23.7.0.3689"},
+		{"machine 4.5", "Machine Agent v4.5.16.2357 GA Build Date 2019-09-25 11:04:36", "4.5.16.2357"},
+	}
+	for _, test := range tests {
+		v, err := ParseAgentVersion(test.raw)
+		if err != nil {
+			t.Errorf("%s: %v", test.agent, err)
+			continue
+		}
+		if v.String() != test.want {
+			t.Errorf("%s: ParseAgentVersion(%q) = %s, want %s", test.agent, test.raw, v, test.want)
+		}
+	}
+
+	for _, raw := range []string{"", "unknown", "Server Agent"} {
+		if v, err := ParseAgentVersion(raw); err == nil {
+			t.Errorf("ParseAgentVersion(%q) = %s, want error", raw, v)
+		}
+	}
+}
@@ -100,3 +100,18 @@ func (s *AnalyticsService) GetAnalyticsSearches() ([]*AnalyticsSearch, error) {
 
 	return analyticsSearches, nil
 }
+
+// CreateAnalyticsSearch saves a new Analytics Search
+// Added 2026 Cisco Systems, Inc.
+func (s *AnalyticsService) CreateAnalyticsSearch(search *AnalyticsSearch) (*AnalyticsSearch, error) {
+
+	url := "controller/restui/analyticsSavedSearches/createAnalyticsSavedSearch"
+
+	var created *AnalyticsSearch
+	err := s.client.RestInternal("POST", url, &created, search)
+	if err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
@@ -0,0 +1,183 @@
+/*
+MIT License
+
+Copyright (c) 2026 Cisco Systems, Inc. and its affiliates
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+
+*/
+
+package anomaly
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	appdrest "github.com/cisco-open/appd-client-go"
+	"github.com/cisco-open/appd-client-go/series"
+)
+
+// Defaults for Options
+const (
+	DefaultThreshold         = 3.5
+	DefaultCriticalThreshold = 6.0
+	DefaultEventType         = "AnomalyDetection"
+)
+
+// Options control how scored points are turned into anomaly windows
+type Options struct {
+	Step              time.Duration // resample the series to this step before detection, 0 keeps the raw points
+	Threshold         float64       // minimum absolute score of an anomalous point, defaults to DefaultThreshold
+	CriticalThreshold float64       // minimum absolute score of an ERROR window, defaults to DefaultCriticalThreshold
+	MaxGap            int           // normal points allowed inside one window
+}
+
+// Point is one anomalous point
+type Point struct {
+	Time     time.Time
+	Value    float64
+	Expected float64
+	Score    float64
+}
+
+// Window is a run of anomalous points of one metric
+type Window struct {
+	MetricPath string
+	Detector   string
+	Severity   string // WARN or ERROR, usable as event severity
+	Start      time.Time
+	End        time.Time
+	MaxScore   float64 // score with the largest absolute value
+	Points     []Point
+}
+
+// String returns a one line description of the window
+func (w Window) String() string {
+	return fmt.Sprintf("%s anomaly (%s) on %s from %s to %s, max score %.2f",
+		w.Severity, w.Detector, w.MetricPath, w.Start.Format(time.RFC3339), w.End.Format(time.RFC3339), w.MaxScore)
+}
+
+// Detect scores every metric with the detector and returns the anomaly windows of all metrics
+func Detect(metrics []*appdrest.MetricData, detector Detector, opts Options) []Window {
+	var windows []Window
+	for _, s := range series.FromMetricData(metrics) {
+		if opts.Step > 0 {
+			s = s.Resample(opts.Step, series.Avg).Fill(opts.Step, series.FillNaN)
+		}
+		windows = append(windows, DetectSeries(s, detector, opts)...)
+	}
+	return windows
+}
+
+// DetectSeries scores a single series and groups anomalous points into windows
+func DetectSeries(s *series.Series, detector Detector, opts Options) []Window {
+	threshold := opts.Threshold
+	if threshold <= 0 {
+		threshold = DefaultThreshold
+	}
+	critical := opts.CriticalThreshold
+	if critical <= 0 {
+		critical = DefaultCriticalThreshold
+	}
+
+	scores := detector.Score(s)
+
+	var windows []Window
+	var current *Window
+	gap := 0
+	for i, p := range s.Points {
+		score := scores[i]
+		if math.IsNaN(score.Score) || math.Abs(score.Score) < threshold {
+			if current != nil {
+				gap++
+				if gap > opts.MaxGap {
+					windows = append(windows, *current)
+					current = nil
+				}
+			}
+			continue
+		}
+
+		gap = 0
+		if current == nil {
+			current = &Window{MetricPath: s.Path, Detector: detector.Name(), Severity: "WARN", Start: p.Time}
+		}
+		current.End = p.Time
+		current.Points = append(current.Points, Point{Time: p.Time, Value: p.Value, Expected: score.Expected, Score: score.Score})
+		if math.Abs(score.Score) > math.Abs(current.MaxScore) {
+			current.MaxScore = score.Score
+		}
+		if math.Abs(score.Score) >= critical {
+			current.Severity = "ERROR"
+		}
+	}
+	if current != nil {
+		windows = append(windows, *current)
+	}
+	return windows
+}
+
+// Event converts a window to a custom event, tier and node are taken from the metric path
+func (w Window) Event(application string, customEventType string) *appdrest.Event {
+	if customEventType == "" {
+		customEventType = DefaultEventType
+	}
+	entities := appdrest.ParseMetricPath(w.MetricPath).Entities()
+
+	peak := w.Points[0]
+	for _, p := range w.Points {
+		if math.Abs(p.Score) > math.Abs(peak.Score) {
+			peak = p
+		}
+	}
+
+	return &appdrest.Event{
+		AppIdOrName:     application,
+		Severity:        w.Severity,
+		Summary:         w.String(),
+		CustomEventType: customEventType,
+		Tier:            entities.Tier,
+		Node:            entities.Node,
+		Properties: map[string]string{
+			"metricPath": w.MetricPath,
+			"detector":   w.Detector,
+			"start":      w.Start.UTC().Format(time.RFC3339),
+			"end":        w.End.UTC().Format(time.RFC3339),
+			"maxScore":   strconv.FormatFloat(w.MaxScore, 'f', 2, 64),
+			"value":      strconv.FormatFloat(peak.Value, 'f', -1, 64),
+			"expected":   strconv.FormatFloat(peak.Expected, 'f', 2, 64),
+		},
+	}
+}
+
+// Publish creates one custom event per window so anomalies appear on the controller timeline
+func Publish(events *appdrest.EventService, application string, customEventType string, windows []Window) error {
+	for _, w := range windows {
+		if len(w.Points) == 0 {
+			continue
+		}
+		err := events.CreateEvent(w.Event(application, customEventType))
+		if err != nil {
+			return fmt.Errorf("publishing %s: %v", w, err)
+		}
+	}
+	return nil
+}
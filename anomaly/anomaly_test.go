@@ -0,0 +1,202 @@
+/*
+MIT License
+
+Copyright (c) 2026 Cisco Systems, Inc. and its affiliates
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+
+*/
+
+package anomaly
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	appdrest "github.com/cisco-open/appd-client-go"
+	"github.com/cisco-open/appd-client-go/series"
+)
+
+var start = time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+
+func newSeries(values ...float64) *series.Series {
+	s := &series.Series{Path: appdrest.BusinessTransactionMetricPath("web", "/pay", appdrest.MetricAverageResponseTime).String()}
+	for i, v := range values {
+		s.Points = append(s.Points, series.Point{Time: start.Add(time.Duration(i) * time.Minute), Value: v})
+	}
+	return s
+}
+
+func near(a float64, b float64) bool {
+	return math.Abs(a-b) < 1e-6
+}
+
+func TestZScore(t *testing.T) {
+	scores := ZScore{Window: 4}.Score(newSeries(10, 12, 10, 12, 30, math.NaN()))
+	for i := 0; i < 3; i++ {
+		if !math.IsNaN(scores[i].Score) {
+			t.Errorf("point %d scored %v with less than 3 points of history", i, scores[i].Score)
+		}
+	}
+	// history 10 12 10: mean 10.667, population standard deviation 0.943
+	if !near(scores[3].Expected, 32.0/3) || !near(scores[3].Score, math.Sqrt2) {
+		t.Errorf("point 3 scored %+v", scores[3])
+	}
+	// history 10 12 10 12: mean 11, standard deviation 1
+	if scores[4].Expected != 11 || scores[4].Score != 19 {
+		t.Errorf("point 4 scored %+v, want expected 11 and score 19", scores[4])
+	}
+	if !math.IsNaN(scores[5].Score) {
+		t.Errorf("missing point scored %v", scores[5].Score)
+	}
+}
+
+func TestMAD(t *testing.T) {
+	tests := []struct {
+		name     string
+		values   []float64
+		expected float64
+		score    float64
+	}{
+		{"spread", []float64{8, 10, 12, 14, 16, 32}, 12, 0.6745 * 20 / 2},
+		{"earlier outlier", []float64{8, 10, 1000, 14, 16, 32}, 14, 0.6745 * 18 / 4},
+		{"constant match", []float64{5, 5, 5, 5}, 5, 0},
+		{"constant deviation", []float64{5, 5, 5, 6}, 5, math.Inf(1)},
+		{"constant drop", []float64{5, 5, 5, 4}, 5, math.Inf(-1)},
+	}
+	for _, test := range tests {
+		scores := MAD{}.Score(newSeries(test.values...))
+		last := scores[len(scores)-1]
+		if last.Expected != test.expected || !(near(last.Score, test.score) || last.Score == test.score) {
+			t.Errorf("%s: scored %+v, want expected %v and score %v", test.name, last, test.expected, test.score)
+		}
+	}
+}
+
+func TestDecompose(t *testing.T) {
+	pattern := []float64{0, 10, 0, -10}
+	var values []float64
+	for i := 0; i < 16; i++ {
+		values = append(values, 100+pattern[i%4])
+	}
+	values[9] += 50
+
+	trend, seasonal, residual := Decompose(values, 4)
+	for i := range values {
+		if i < 2 || i > 14 {
+			if !math.IsNaN(trend[i]) {
+				t.Errorf("trend[%d] = %v, want NaN for the first and last half period", i, trend[i])
+			}
+			continue
+		}
+		want := 0.0
+		if i == 9 {
+			want = 50
+		}
+		if trend[i] != 100 || seasonal[i] != pattern[i%4] || residual[i] != want {
+			t.Errorf("point %d: trend %v, seasonal %v, residual %v", i, trend[i], seasonal[i], residual[i])
+		}
+	}
+
+	if trend, _, _ := Decompose(values[:7], 4); trend != nil {
+		t.Errorf("decomposed less than two periods")
+	}
+}
+
+func TestSeasonal(t *testing.T) {
+	pattern := []float64{0, 10, 0, -10}
+	var values []float64
+	for i := 0; i < 24; i++ {
+		values = append(values, 100+pattern[i%4]+float64(i%3)*0.5)
+	}
+	values[13] += 40
+
+	scores := Seasonal{Period: 4}.Score(newSeries(values...))
+	for i, score := range scores {
+		if math.IsNaN(score.Score) {
+			continue
+		}
+		if anomalous := math.Abs(score.Score) >= DefaultThreshold; anomalous != (i == 13) {
+			t.Errorf("point %d scored %v", i, score.Score)
+		}
+	}
+
+	for _, score := range (Seasonal{Period: 4}).Score(newSeries(values[:7]...)) {
+		if !math.IsNaN(score.Score) || !math.IsNaN(score.Expected) {
+			t.Errorf("less than two periods scored %+v", score)
+		}
+	}
+}
+
+// fixedScores returns the given scores for every series
+type fixedScores []float64
+
+func (f fixedScores) Name() string {
+	return "fixed"
+}
+
+func (f fixedScores) Score(s *series.Series) []Score {
+	scores := make([]Score, len(f))
+	for i, score := range f {
+		scores[i] = Score{Expected: 1, Score: score}
+	}
+	return scores
+}
+
+func TestDetectSeries(t *testing.T) {
+	detector := fixedScores{0, 4, -5, 0, 4, 0, 0, 7, math.NaN(), 3}
+	s := newSeries(make([]float64, len(detector))...)
+
+	windows := DetectSeries(s, detector, Options{MaxGap: 1})
+	if len(windows) != 2 {
+		t.Fatalf("windows %v", windows)
+	}
+	first, second := windows[0], windows[1]
+	if !first.Start.Equal(start.Add(time.Minute)) || !first.End.Equal(start.Add(4*time.Minute)) || len(first.Points) != 3 || first.MaxScore != -5 || first.Severity != "WARN" {
+		t.Errorf("first window %s with %d points", first, len(first.Points))
+	}
+	if !second.Start.Equal(start.Add(7*time.Minute)) || !second.End.Equal(second.Start) || second.MaxScore != 7 || second.Severity != "ERROR" {
+		t.Errorf("second window %s", second)
+	}
+
+	if windows := DetectSeries(s, detector, Options{Threshold: 6}); len(windows) != 1 || windows[0].MaxScore != 7 {
+		t.Errorf("windows above 6: %v", windows)
+	}
+	if windows := DetectSeries(s, detector, Options{}); len(windows) != 3 {
+		t.Errorf("windows without gaps: %v", windows)
+	}
+}
+
+func TestWindowEvent(t *testing.T) {
+	s := newSeries(make([]float64, 3)...)
+	s.Points[1].Value = 250
+	windows := DetectSeries(s, fixedScores{0, 8, 0}, Options{})
+	if len(windows) != 1 {
+		t.Fatalf("windows %v", windows)
+	}
+
+	event := windows[0].Event("shop", "")
+	if event.AppIdOrName != "shop" || event.Severity != "ERROR" || event.CustomEventType != DefaultEventType || event.Tier != "web" {
+		t.Errorf("event %+v", event)
+	}
+	if event.Properties["value"] != "250" || event.Properties["maxScore"] != "8.00" || event.Properties["start"] != "2026-10-19T12:01:00Z" {
+		t.Errorf("event properties %v", event.Properties)
+	}
+}
@@ -0,0 +1,244 @@
+/*
+MIT License
+
+Copyright (c) 2026 Cisco Systems, Inc. and its affiliates
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+
+*/
+
+// Package anomaly detects statistical anomalies in fetched metric series and
+// publishes them as custom events on the controller timeline.
+package anomaly
+
+import (
+	"math"
+
+	"github.com/cisco-open/appd-client-go/series"
+)
+
+// Score is the anomaly score of one point of a series
+type Score struct {
+	Expected float64 // value the detector expected, NaN when unknown
+	Score    float64 // deviation from Expected in standard units, NaN when it could not be computed
+}
+
+// Detector scores every point of a series
+type Detector interface {
+	Name() string
+	Score(s *series.Series) []Score
+}
+
+// ZScore scores each point against the mean and standard deviation of the Window points before it
+type ZScore struct {
+	Window int // number of previous points, defaults to 30
+}
+
+// Name returns the detector name
+func (d ZScore) Name() string {
+	return "zscore"
+}
+
+// Score computes the rolling z-score of every point
+func (d ZScore) Score(s *series.Series) []Score {
+	window := d.Window
+	if window <= 0 {
+		window = 30
+	}
+	return rolling(s, window, func(history []float64, value float64) Score {
+		mean := series.Mean(history)
+		return Score{Expected: mean, Score: standardize(value-mean, series.StdDev(history))}
+	})
+}
+
+// MAD scores each point with the modified z-score based on the median absolute deviation
+// of the Window points before it, it is less sensitive to earlier outliers than ZScore
+type MAD struct {
+	Window int // number of previous points, defaults to 30
+}
+
+// Name returns the detector name
+func (d MAD) Name() string {
+	return "mad"
+}
+
+// Score computes the rolling modified z-score of every point
+func (d MAD) Score(s *series.Series) []Score {
+	window := d.Window
+	if window <= 0 {
+		window = 30
+	}
+	return rolling(s, window, func(history []float64, value float64) Score {
+		median, mad := medianAbsoluteDeviation(history)
+		return Score{Expected: median, Score: standardize(0.6745*(value-median), mad)}
+	})
+}
+
+// Seasonal decomposes the series into trend, seasonal and residual components
+// and scores the residuals with the median absolute deviation.
+// Period is the number of points of one season, e.g. 24 for hourly points with a daily pattern.
+type Seasonal struct {
+	Period int
+}
+
+// Name returns the detector name
+func (d Seasonal) Name() string {
+	return "seasonal"
+}
+
+// Score computes the robust score of the residual of every point
+func (d Seasonal) Score(s *series.Series) []Score {
+	values := make([]float64, len(s.Points))
+	for i, p := range s.Points {
+		values[i] = p.Value
+	}
+
+	scores := make([]Score, len(values))
+	trend, seasonal, residual := Decompose(values, d.Period)
+	if trend == nil {
+		for i := range scores {
+			scores[i] = Score{Expected: math.NaN(), Score: math.NaN()}
+		}
+		return scores
+	}
+
+	var valid []float64
+	for _, r := range residual {
+		if !math.IsNaN(r) {
+			valid = append(valid, r)
+		}
+	}
+	median, mad := medianAbsoluteDeviation(valid)
+
+	for i := range values {
+		scores[i] = Score{Expected: trend[i] + seasonal[i], Score: standardize(0.6745*(residual[i]-median), mad)}
+	}
+	return scores
+}
+
+// Decompose splits values into trend (centered moving median over one period), seasonal
+// (median detrended value per phase, centered on zero) and residual components.
+// Medians keep the components from absorbing the anomalies they are used to find.
+// It returns nil slices when there are fewer than two periods of values.
+func Decompose(values []float64, period int) (trend []float64, seasonal []float64, residual []float64) {
+	if period < 2 || len(values) < 2*period {
+		return nil, nil, nil
+	}
+
+	// the trend is unknown for the first and last half period, like in classical decomposition
+	trend = make([]float64, len(values))
+	half := period / 2
+	for i := range values {
+		from, to := i-half, i+half
+		if period%2 == 0 {
+			to--
+		}
+		if from < 0 || to >= len(values) {
+			trend[i] = math.NaN()
+			continue
+		}
+		trend[i] = series.Percentile(finite(values[from:to+1]), 50)
+	}
+
+	phases := make([][]float64, period)
+	for i, v := range values {
+		if d := v - trend[i]; !math.IsNaN(d) {
+			phases[i%period] = append(phases[i%period], d)
+		}
+	}
+	means := make([]float64, period)
+	for i, phase := range phases {
+		means[i] = series.Percentile(phase, 50)
+		if math.IsNaN(means[i]) {
+			means[i] = 0
+		}
+	}
+	offset := series.Mean(means)
+
+	seasonal = make([]float64, len(values))
+	residual = make([]float64, len(values))
+	for i, v := range values {
+		seasonal[i] = means[i%period] - offset
+		residual[i] = v - trend[i] - seasonal[i]
+	}
+	return trend, seasonal, residual
+}
+
+// rolling scores every point against the finite values of the window before it
+func rolling(s *series.Series, window int, score func(history []float64, value float64) Score) []Score {
+	scores := make([]Score, len(s.Points))
+	for i, p := range s.Points {
+		from := i - window
+		if from < 0 {
+			from = 0
+		}
+		history := make([]float64, 0, window)
+		for _, h := range s.Points[from:i] {
+			if !math.IsNaN(h.Value) {
+				history = append(history, h.Value)
+			}
+		}
+		if len(history) < 3 || math.IsNaN(p.Value) {
+			scores[i] = Score{Expected: math.NaN(), Score: math.NaN()}
+			continue
+		}
+		scores[i] = score(history, p.Value)
+	}
+	return scores
+}
+
+// standardize divides a deviation by a spread, a zero spread only scores exact matches as normal
+func standardize(deviation float64, spread float64) float64 {
+	if spread == 0 || math.IsNaN(spread) {
+		switch {
+		case deviation == 0:
+			return 0
+		case deviation > 0:
+			return math.Inf(1)
+		default:
+			return math.Inf(-1)
+		}
+	}
+	return deviation / spread
+}
+
+// medianAbsoluteDeviation returns the median and the MAD of values.
+// When more than half of the values are equal the MAD is zero, the scaled mean absolute deviation is used instead.
+func medianAbsoluteDeviation(values []float64) (float64, float64) {
+	median := series.Percentile(values, 50)
+	deviations := make([]float64, len(values))
+	for i, v := range values {
+		deviations[i] = math.Abs(v - median)
+	}
+	mad := series.Percentile(deviations, 50)
+	if mad == 0 {
+		mad = 1.253314 * 0.6745 * series.Mean(deviations)
+	}
+	return median, mad
+}
+
+func finite(values []float64) []float64 {
+	out := make([]float64, 0, len(values))
+	for _, v := range values {
+		if !math.IsNaN(v) && !math.IsInf(v, 0) {
+			out = append(out, v)
+		}
+	}
+	return out
+}
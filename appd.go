@@ -32,10 +32,12 @@ import (
 	"fmt"
 	"io"
 	"io/ioutil"
+	"mime/multipart"
 	"net"
 	"net/http"
 	"net/url"
 	"os"
+	"regexp"
 	"strings"
 	"time"
 
@@ -77,6 +79,14 @@ type Client struct {
 	HealthRule          *HealthRuleService
 	Event               *EventService
 	TxDetectionRule     *TransactionRulesService
+	Policy              *PolicyService
+	Action              *ActionService
+	EUM                 *EUMService
+	MobileApp           *MobileAppService
+	DatabaseMonitoring  *DatabaseMonitoringService
+	Topology            *TopologyService
+	Machine             *MachineService
+	BackendDetection    *BackendDetectionService
 }
 
 type service struct {
@@ -173,6 +183,15 @@ func NewClientProxy(protocol string, controllerHost string, port int, username s
 	c.HealthRule = (*HealthRuleService)(&c.common)
 	c.Event = (*EventService)(&c.common)
 	c.TxDetectionRule = (*TransactionRulesService)(&c.common)
+	// Added 2026 Cisco Systems, Inc.
+	c.Policy = (*PolicyService)(&c.common)
+	c.Action = (*ActionService)(&c.common)
+	c.EUM = (*EUMService)(&c.common)
+	c.MobileApp = (*MobileAppService)(&c.common)
+	c.DatabaseMonitoring = (*DatabaseMonitoringService)(&c.common)
+	c.Topology = (*TopologyService)(&c.common)
+	c.Machine = (*MachineService)(&c.common)
+	c.BackendDetection = (*BackendDetectionService)(&c.common)
 
 	c.log.Debug("Created client successfully")
 	return c, nil
@@ -373,6 +392,86 @@ func (c *Client) DoRawRequest(method string, url string, body interface{}) ([]by
 	return responseString, nil
 }
 
+// uploadFile posts content as multipart file to an import servlet and returns its plain text answer.
+// The servlets answer rejected files with status 200 as well, so the answer is checked for error messages.
+// Added 2026 Cisco Systems, Inc.
+func (c *Client) uploadFile(url string, fileName string, content []byte) (string, error) {
+
+	multipartPayload := &bytes.Buffer{}
+	writer := multipart.NewWriter(multipartPayload)
+	part, err := writer.CreateFormFile("file", fileName)
+	if err != nil {
+		return "", err
+	}
+	_, err = part.Write(content)
+	if err != nil {
+		return "", err
+	}
+	err = writer.Close()
+	if err != nil {
+		return "", err
+	}
+
+	req, err := c.newRequestBodyBytes("POST", url, multipartPayload)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	req.URL.RawQuery = req.URL.Query().Encode()
+
+	err = c.login(req)
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return "", err
+	}
+	answer := strings.TrimSpace(string(body))
+
+	if resp.StatusCode >= 400 {
+		return answer, &APIError{
+			Code:    resp.StatusCode,
+			Message: fmt.Sprintf("Status Code Error: %d\n%s", resp.StatusCode, answer),
+		}
+	}
+	if importFailed(answer) {
+		return answer, fmt.Errorf("import of %s rejected: %s", fileName, answer)
+	}
+	return answer, nil
+}
+
+// importFailedLine matches the lines import servlets answer rejected files with,
+// e.g. "Error: rule-list is missing" or "Failed to import rule cpu"
+var importFailedLine = regexp.MustCompile(`(?i)^(?:error|failure|failed)(?:\s*:|\s+to\b|\s+(?:while|during)\b)`)
+
+// importException matches a Java exception class at the start of a line, e.g. "java.lang.IllegalArgumentException: bad xml"
+var importException = regexp.MustCompile(`^(?:[a-z_$][\w$]*\.)+[A-Z][\w$]*(?:Exception|Error)(?::|$)`)
+
+// importFailed reports whether the answer of an import servlet describes a failure.
+// Rejected files are answered with status 200 as well, either with an error page, an exception
+// or an error line. Messages merely mentioning errors, e.g. "error detection imported", are not failures.
+func importFailed(answer string) bool {
+	lower := strings.ToLower(answer)
+	if strings.HasPrefix(lower, "<!doctype html") || strings.HasPrefix(lower, "<html") {
+		return true
+	}
+	for _, line := range strings.Split(answer, "\n") {
+		line = strings.TrimSpace(line)
+		if importFailedLine.MatchString(line) || importException.MatchString(line) {
+			return true
+		}
+	}
+	return false
+}
+
 func (c *Client) login(req *http.Request) error {
 
 	url := "/auth?action=login"
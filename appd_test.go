@@ -0,0 +1,87 @@
+/*
+MIT License
+
+Copyright (c) 2026 Cisco Systems, Inc. and its affiliates
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+
+*/
+
+package appdrest
+
+import (
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/cisco-open/appd-client-go/internal/apptest"
+)
+
+// newTestClient returns a client for a controller served by handler
+func newTestClient(t *testing.T, handler http.Handler) *Client {
+	t.Helper()
+	host, port := apptest.Server(t, handler)
+	client, err := NewClient("http", host, port, "user", "secret", "customer1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return client
+}
+
+func TestUploadFile(t *testing.T) {
+	tests := []struct {
+		answer  string
+		status  int
+		wantErr bool
+	}{
+		{"Rules imported successfully", http.StatusOK, false},
+		{"Error: rule-list is missing", http.StatusOK, true},
+		{"java.lang.IllegalArgumentException: bad xml", http.StatusOK, true},
+		{"Imported error detection configuration", http.StatusOK, false},
+		{"Error detection rules imported successfully\nNo invalid rules found", http.StatusOK, false},
+		{"Imported 2 rules\nFailed to import rule cpu", http.StatusOK, true},
+		{"com.singularity.ee.controller.api.exceptions.ImportException\n\tat com.singularity.Importer.run", http.StatusOK, true},
+		{"<!DOCTYPE html><html><title>Error</title></html>", http.StatusOK, true},
+		{"", http.StatusInternalServerError, true},
+	}
+	for _, test := range tests {
+		var got string
+		client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Header.Get("X-CSRF-TOKEN") != apptest.CSRFToken {
+				t.Errorf("upload without CSRF token")
+			}
+			file, _, err := r.FormFile("file")
+			if err != nil {
+				t.Fatal(err)
+			}
+			body, _ := io.ReadAll(file)
+			got = string(body)
+			w.WriteHeader(test.status)
+			io.WriteString(w, test.answer)
+		}))
+
+		_, err := client.uploadFile("controller/transactiondetection/1/custom", "rules.xml", []byte("<mds-data/>"))
+		if (err != nil) != test.wantErr {
+			t.Errorf("answer %q: error %v, want error %t", test.answer, err, test.wantErr)
+		}
+		if got != "<mds-data/>" {
+			t.Errorf("uploaded %q", got)
+		}
+	}
+}
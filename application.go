@@ -27,7 +27,9 @@ SOFTWARE.
 package appdrest
 
 import (
+	"errors"
 	"fmt"
+	"net/http"
 )
 
 // allApplicationTypes is a wrapper on the json response of GetApplicationAllTypes
@@ -191,10 +193,10 @@ func (s *ApplicationService) ExportApplicationConfig(appID int) ([]byte, error)
 // this is an UNPUBLISHED API call - it may change in the future
 func (s *ApplicationService) GetAllInternalApplications() (*AllInternalApplications, error) {
 
-	url := "/controller/restui/applicationManagerUiBean/getApplicationsAllTypes"
+	url := "controller/restui/applicationManagerUiBean/getApplicationsAllTypes"
 
 	apps := AllInternalApplications{}
-	err := s.client.Rest("GET", url, &apps, nil)
+	err := s.client.RestInternal("GET", url, &apps, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -203,3 +205,220 @@ func (s *ApplicationService) GetAllInternalApplications() (*AllInternalApplicati
 }
 
 // DANGER ZONE END
+
+// ErrApplicationExists is returned when an application name is already taken
+// Added 2026 Cisco Systems, Inc.
+var ErrApplicationExists = errors.New("application name already taken")
+
+// ErrApplicationNotFound is returned when an application does not exist
+// Added 2026 Cisco Systems, Inc.
+var ErrApplicationNotFound = errors.New("application not found")
+
+// DANGER ZONE
+// following types are used for UNPUBLISHED api call and it may change in the future
+type applicationDetails struct {
+	ID          int    `json:"id,omitempty"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+// DANGER ZONE END
+
+// GetApplicationByName returns the APM application with the given name,
+// ErrApplicationNotFound is returned when there is none
+// Added 2026 Cisco Systems, Inc.
+func (s *ApplicationService) GetApplicationByName(name string) (*Application, error) {
+	apps, err := s.GetApplications()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, app := range apps {
+		if app.Name == name {
+			return app, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w: %s", ErrApplicationNotFound, name)
+}
+
+// CreateApplication creates an APM application,
+// ErrApplicationExists is returned without an application when the name is already taken,
+// GetOrCreateApplication reuses the existing one instead
+// DANGER ZONE
+// this is an UNPUBLISHED API call - it may change in the future
+// Added 2026 Cisco Systems, Inc.
+func (s *ApplicationService) CreateApplication(name string, description string) (*Application, error) {
+
+	existing, err := s.GetApplicationByName(name)
+	if err == nil {
+		return nil, fmt.Errorf("%w: %s (id %d)", ErrApplicationExists, name, existing.ID)
+	}
+	if !errors.Is(err, ErrApplicationNotFound) {
+		return nil, err
+	}
+
+	url := "controller/restui/allApplications/createApplication?applicationType=APM"
+
+	body := applicationDetails{Name: name, Description: description}
+	var app *Application
+	err = s.client.RestInternal("POST", url, &app, &body)
+	if err != nil {
+		if apiErr, ok := err.(*APIError); ok && apiErr.Code == http.StatusConflict {
+			return nil, fmt.Errorf("%w: %s", ErrApplicationExists, name)
+		}
+		return nil, err
+	}
+
+	return app, nil
+}
+
+// GetOrCreateApplication returns the APM application with the given name,
+// it is created with the description when there is none
+// DANGER ZONE
+// this is an UNPUBLISHED API call - it may change in the future
+// Added 2026 Cisco Systems, Inc.
+func (s *ApplicationService) GetOrCreateApplication(name string, description string) (app *Application, created bool, err error) {
+
+	app, err = s.CreateApplication(name, description)
+	if errors.Is(err, ErrApplicationExists) {
+		// the name is taken or was taken concurrently
+		app, err = s.GetApplicationByName(name)
+		return app, false, err
+	}
+	if err != nil {
+		return nil, false, err
+	}
+
+	return app, true, nil
+}
+
+// UpdateApplication renames an application and sets its description,
+// ErrApplicationExists is returned when renaming to a name that is already taken
+// DANGER ZONE
+// this is an UNPUBLISHED API call - it may change in the future
+// Added 2026 Cisco Systems, Inc.
+func (s *ApplicationService) UpdateApplication(appID int, name string, description string) error {
+
+	existing, err := s.GetApplicationByName(name)
+	if err == nil && existing.ID != appID {
+		return fmt.Errorf("%w: %s (id %d)", ErrApplicationExists, name, existing.ID)
+	}
+	if err != nil && !errors.Is(err, ErrApplicationNotFound) {
+		return err
+	}
+
+	url := "controller/restui/allApplications/updateApplicationDetails"
+
+	body := applicationDetails{ID: appID, Name: name, Description: description}
+	err = s.client.RestInternal("POST", url, nil, &body)
+	if err != nil {
+		if fmt.Sprintf("%s", err) == "EOF" { // successful call returns EOF error -> empty body
+			return nil
+		}
+		return err
+	}
+
+	return nil
+}
+
+// DeleteApplication deletes an application with all its configuration and data
+// DANGER ZONE
+// this is an UNPUBLISHED API call - it may change in the future
+// Added 2026 Cisco Systems, Inc.
+func (s *ApplicationService) DeleteApplication(appID int) error {
+
+	url := "controller/restui/allApplications/deleteApplication"
+
+	err := s.client.RestInternal("POST", url, nil, appID)
+	if err != nil {
+		if fmt.Sprintf("%s", err) == "EOF" { // successful call returns EOF error -> empty body
+			return nil
+		}
+		return err
+	}
+
+	return nil
+}
+
+// EnsureApplication returns the application with the given name, creating it when missing
+// and updating its description when it differs. created reports whether it was created.
+// Added 2026 Cisco Systems, Inc.
+func (s *ApplicationService) EnsureApplication(name string, description string) (app *Application, created bool, err error) {
+
+	app, err = s.GetApplicationByName(name)
+	if err != nil {
+		if !errors.Is(err, ErrApplicationNotFound) {
+			return nil, false, err
+		}
+		app, err = s.CreateApplication(name, description)
+		if err != nil {
+			return nil, false, err
+		}
+		return app, true, nil
+	}
+
+	if app.Description != description {
+		err = s.UpdateApplication(app.ID, name, description)
+		if err != nil {
+			return nil, false, err
+		}
+		app.Description = description
+	}
+
+	return app, false, nil
+}
+
+// EnsureApplicationDeleted deletes the application with the given name if it exists.
+// deleted reports whether an application was deleted.
+// Added 2026 Cisco Systems, Inc.
+func (s *ApplicationService) EnsureApplicationDeleted(name string) (deleted bool, err error) {
+
+	app, err := s.GetApplicationByName(name)
+	if err != nil {
+		if errors.Is(err, ErrApplicationNotFound) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	err = s.DeleteApplication(app.ID)
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// ImportApplicationConfig uploads an application configuration XML as produced by ExportApplicationConfig
+// into an existing application, overwrite replaces existing objects of the same name.
+// An answer of the servlet reporting a failure is returned as error.
+// Added 2026 Cisco Systems, Inc.
+func (s *ApplicationService) ImportApplicationConfig(appID int, xml []byte, overwrite bool) error {
+
+	url := fmt.Sprintf("controller/ConfigObjectImportExportServlet?applicationId=%d&overwrite=%t", appID, overwrite)
+
+	// the servlet reports rejected files in a plain text answer with status 200
+	_, err := s.client.uploadFile(url, "application.xml", xml)
+	return err
+}
+
+// GetApplicationConfig exports an application and parses the configuration XML
+// Added 2026 Cisco Systems, Inc.
+func (s *ApplicationService) GetApplicationConfig(appID int) (*ApplicationConfig, error) {
+	body, err := s.ExportApplicationConfig(appID)
+	if err != nil {
+		return nil, err
+	}
+	return ParseApplicationConfig(body)
+}
+
+// UploadApplicationConfig serializes a parsed configuration and imports it into an application
+// Added 2026 Cisco Systems, Inc.
+func (s *ApplicationService) UploadApplicationConfig(appID int, config *ApplicationConfig, overwrite bool) error {
+	body, err := config.Marshal()
+	if err != nil {
+		return err
+	}
+	return s.ImportApplicationConfig(appID, body, overwrite)
+}
@@ -0,0 +1,248 @@
+/*
+MIT License
+
+Copyright (c) 2026 Cisco Systems, Inc. and its affiliates
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+
+*/
+
+package appdrest
+
+import (
+	"bytes"
+	"encoding/xml"
+	"reflect"
+)
+
+// ConfigElement keeps an XML element of the application export that has no typed model,
+// so parsing and marshalling a configuration does not lose anything
+type ConfigElement struct {
+	XMLName xml.Name
+	Attrs   []xml.Attr `xml:",any,attr"`
+	Content string     `xml:",innerxml"`
+}
+
+// ApplicationConfig is the application configuration as exported by ExportApplicationConfig.
+// Only the commonly edited parts are typed, all other elements are kept in Other.
+// Marshal writes a parsed configuration back as exported and only rewrites the edited elements,
+// a configuration built in code is written with its typed elements before untyped ones.
+type ApplicationConfig struct {
+	XMLName              xml.Name                    `xml:"application"`
+	Attrs                []xml.Attr                  `xml:",any,attr"`
+	Name                 string                      `xml:"name"`
+	Description          string                      `xml:"description"`
+	Tiers                []ConfigTier                `xml:"application-components>application-component"`
+	BusinessTransactions []ConfigBusinessTransaction `xml:"business-transactions>business-transaction"`
+	CustomMatchPoints    []ConfigCustomMatchPoint    `xml:"custom-match-points>custom-match-point"`
+	ErrorConfigurations  []ConfigErrorConfiguration  `xml:"error-configurations>error-configuration"`
+	DataCollectors       []ConfigDataCollector       `xml:"data-gatherer-configs>data-gatherer-config"`
+	Policies             []ConfigPolicy              `xml:"policies>policy"`
+	Other                []ConfigElement             `xml:",any"`
+
+	source *configSource // set by ParseApplicationConfig, the items of the sections have their own
+}
+
+// ConfigTier is a tier (application component) in the application export
+type ConfigTier struct {
+	Attrs         []xml.Attr      `xml:",any,attr"`
+	Name          string          `xml:"name"`
+	Description   string          `xml:"description"`
+	ComponentType string          `xml:"component-type"`
+	Other         []ConfigElement `xml:",any"`
+
+	source *configSource
+}
+
+// ConfigBusinessTransaction is a registered business transaction in the application export
+type ConfigBusinessTransaction struct {
+	Attrs           []xml.Attr      `xml:",any,attr"`
+	TransactionType string          `xml:"transaction-type,attr"`
+	Name            string          `xml:"name"`
+	Tier            string          `xml:"application-component"`
+	EntryPointType  string          `xml:"entry-point-type"`
+	Background      bool            `xml:"background"`
+	Other           []ConfigElement `xml:",any"`
+
+	source *configSource
+}
+
+// ConfigCustomMatchPoint is a custom transaction detection rule in the application export
+type ConfigCustomMatchPoint struct {
+	Attrs               []xml.Attr      `xml:",any,attr"`
+	Name                string          `xml:"name"`
+	BusinessTransaction string          `xml:"business-transaction-name"`
+	EntryPoint          string          `xml:"entry-point"`
+	Background          bool            `xml:"background"`
+	Enabled             bool            `xml:"enabled"`
+	MatchRule           *ConfigElement  `xml:"match-rule"`
+	Other               []ConfigElement `xml:",any"`
+
+	source *configSource
+}
+
+// ConfigErrorConfiguration is the error detection configuration of one agent type
+type ConfigErrorConfiguration struct {
+	Attrs     []xml.Attr      `xml:",any,attr"`
+	AgentType string          `xml:"agent-type,attr"`
+	Other     []ConfigElement `xml:",any"`
+
+	source *configSource
+}
+
+// ConfigDataCollector is a HTTP or method invocation data collector in the application export
+type ConfigDataCollector struct {
+	Attrs               []xml.Attr      `xml:",any,attr"`
+	GathererType        string          `xml:"gatherer-type,attr"`
+	Name                string          `xml:"name"`
+	EnabledForApm       bool            `xml:"enabled-for-apm"`
+	EnabledForAnalytics bool            `xml:"enabled-for-analytics"`
+	AttachToNewBTs      bool            `xml:"attach-to-new-bts"`
+	Other               []ConfigElement `xml:",any"`
+
+	source *configSource
+}
+
+// ConfigPolicy is a policy in the application export
+type ConfigPolicy struct {
+	Attrs   []xml.Attr      `xml:",any,attr"`
+	Name    string          `xml:"name"`
+	Enabled bool            `xml:"enabled"`
+	Other   []ConfigElement `xml:",any"`
+
+	source *configSource
+}
+
+// ParseApplicationConfig parses the XML returned by ExportApplicationConfig
+func ParseApplicationConfig(body []byte) (*ApplicationConfig, error) {
+	var config ApplicationConfig
+	err := xml.Unmarshal(body, &config)
+	if err != nil {
+		return nil, err
+	}
+	err = attachConfigSource(reflect.ValueOf(&config).Elem(), body)
+	if err != nil {
+		return nil, err
+	}
+	return &config, nil
+}
+
+// MarshalXML writes the configuration, sections without elements are left out
+func (c *ApplicationConfig) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type tiers struct {
+		Items []ConfigTier `xml:"application-component"`
+	}
+	type businessTransactions struct {
+		Items []ConfigBusinessTransaction `xml:"business-transaction"`
+	}
+	type customMatchPoints struct {
+		Items []ConfigCustomMatchPoint `xml:"custom-match-point"`
+	}
+	type errorConfigurations struct {
+		Items []ConfigErrorConfiguration `xml:"error-configuration"`
+	}
+	type dataCollectors struct {
+		Items []ConfigDataCollector `xml:"data-gatherer-config"`
+	}
+	type policies struct {
+		Items []ConfigPolicy `xml:"policy"`
+	}
+
+	out := struct {
+		Attrs                []xml.Attr            `xml:",any,attr"`
+		Name                 string                `xml:"name"`
+		Description          string                `xml:"description"`
+		Tiers                *tiers                `xml:"application-components"`
+		BusinessTransactions *businessTransactions `xml:"business-transactions"`
+		CustomMatchPoints    *customMatchPoints    `xml:"custom-match-points"`
+		ErrorConfigurations  *errorConfigurations  `xml:"error-configurations"`
+		DataCollectors       *dataCollectors       `xml:"data-gatherer-configs"`
+		Policies             *policies             `xml:"policies"`
+		Other                []ConfigElement       `xml:",any"`
+	}{Attrs: c.Attrs, Name: c.Name, Description: c.Description, Other: c.Other}
+
+	if len(c.Tiers) > 0 {
+		out.Tiers = &tiers{c.Tiers}
+	}
+	if len(c.BusinessTransactions) > 0 {
+		out.BusinessTransactions = &businessTransactions{c.BusinessTransactions}
+	}
+	if len(c.CustomMatchPoints) > 0 {
+		out.CustomMatchPoints = &customMatchPoints{c.CustomMatchPoints}
+	}
+	if len(c.ErrorConfigurations) > 0 {
+		out.ErrorConfigurations = &errorConfigurations{c.ErrorConfigurations}
+	}
+	if len(c.DataCollectors) > 0 {
+		out.DataCollectors = &dataCollectors{c.DataCollectors}
+	}
+	if len(c.Policies) > 0 {
+		out.Policies = &policies{c.Policies}
+	}
+
+	start.Name = xml.Name{Local: "application"}
+	start.Attr = nil
+	return e.EncodeElement(out, start)
+}
+
+// Marshal serializes the configuration in the format accepted by ImportApplicationConfig
+func (c *ApplicationConfig) Marshal() ([]byte, error) {
+	if c.source != nil {
+		body, ok, err := c.source.patch(reflect.ValueOf(c).Elem())
+		if err != nil || ok {
+			return body, err
+		}
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString(xml.Header)
+	enc := xml.NewEncoder(&buf)
+	enc.Indent("", "    ")
+	err := enc.Encode(c)
+	if err != nil {
+		return nil, err
+	}
+	buf.WriteString("\n")
+	return buf.Bytes(), nil
+}
+
+// BusinessTransactionsByTier returns the business transactions of one tier
+func (c *ApplicationConfig) BusinessTransactionsByTier(tier string) []*ConfigBusinessTransaction {
+	var bts []*ConfigBusinessTransaction
+	for i := range c.BusinessTransactions {
+		if c.BusinessTransactions[i].Tier == tier {
+			bts = append(bts, &c.BusinessTransactions[i])
+		}
+	}
+	return bts
+}
+
+// RenameTier renames a tier and every business transaction reference to it
+func (c *ApplicationConfig) RenameTier(oldName string, newName string) {
+	for i := range c.Tiers {
+		if c.Tiers[i].Name == oldName {
+			c.Tiers[i].Name = newName
+		}
+	}
+	for i := range c.BusinessTransactions {
+		if c.BusinessTransactions[i].Tier == oldName {
+			c.BusinessTransactions[i].Tier = newName
+		}
+	}
+}
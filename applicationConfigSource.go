@@ -0,0 +1,401 @@
+/*
+MIT License
+
+Copyright (c) 2026 Cisco Systems, Inc. and its affiliates
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+
+*/
+
+package appdrest
+
+import (
+	"bytes"
+	"encoding/xml"
+	"io"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// configSpan is the position of an element in the XML it was parsed from
+type configSpan struct {
+	lead         int // start of the whitespace in front of the element
+	start        int
+	contentStart int
+	contentEnd   int
+	end          int
+	selfClosing  bool
+}
+
+// configSection is a wrapper element like application-components with the positions of its items
+type configSection struct {
+	configSpan
+	items   []configSpan
+	sources []*configSource // sources of the parsed items, in document order
+}
+
+// configSource remembers the XML a configuration element was parsed from,
+// so Marshal writes everything that was not edited back byte for byte
+type configSource struct {
+	raw          []byte
+	parsed       reflect.Value // the element as parsed, edits are found by comparing with it
+	root         configSpan
+	children     map[string]configSpan // typed child elements by name
+	sections     map[string]*configSection
+	indent       string // whitespace in front of the first child element
+	lastChildEnd int
+}
+
+// configSourced is implemented by the configuration types that remember their source
+type configSourced interface {
+	configSource() *configSource
+	setConfigSource(src *configSource)
+}
+
+// configEdit replaces raw[start:end] with text
+type configEdit struct {
+	start int
+	end   int
+	text  []byte
+}
+
+// configField describes how a field of a configuration type is written
+type configField struct {
+	index   int
+	name    string // child element name of leaves and sections
+	item    string // item element name of sections
+	leaf    bool   // string or bool child element
+	section bool   // slice in a wrapper element, e.g. "application-components>application-component"
+}
+
+// configFields classifies the exported fields of a configuration type
+func configFields(typ reflect.Type) []configField {
+	var fields []configField
+	for i := 0; i < typ.NumField(); i++ {
+		f := typ.Field(i)
+		if f.PkgPath != "" || f.Name == "XMLName" {
+			continue
+		}
+		tag := strings.Split(f.Tag.Get("xml"), ",")
+		field := configField{index: i}
+		switch {
+		case len(tag) > 1 || tag[0] == "":
+			// attributes, innerxml and ",any" elements are only compared
+		case strings.Contains(tag[0], ">"):
+			names := strings.SplitN(tag[0], ">", 2)
+			field.name, field.item, field.section = names[0], names[1], true
+		case f.Type.Kind() == reflect.String || f.Type.Kind() == reflect.Bool:
+			field.name, field.leaf = tag[0], true
+		}
+		fields = append(fields, field)
+	}
+	return fields
+}
+
+// attachConfigSource records raw as the source of v and of all items in its sections,
+// v must be addressable and implement configSourced
+func attachConfigSource(v reflect.Value, raw []byte) error {
+	src, err := newConfigSource(raw, v.Type())
+	if err != nil {
+		return err
+	}
+	v.Addr().Interface().(configSourced).setConfigSource(src)
+
+	for _, field := range configFields(v.Type()) {
+		section := src.sections[field.name]
+		items := v.Field(field.index)
+		if !field.section || section == nil || items.Len() != len(section.items) {
+			continue // items without a source are encoded again
+		}
+		for i, span := range section.items {
+			err = attachConfigSource(items.Index(i), raw[span.start:span.end])
+			if err != nil {
+				return err
+			}
+			section.sources = append(section.sources, items.Index(i).Addr().Interface().(configSourced).configSource())
+		}
+	}
+	return nil
+}
+
+// newConfigSource parses raw a second time and records the positions of the child elements
+func newConfigSource(raw []byte, typ reflect.Type) (*configSource, error) {
+	parsed := reflect.New(typ)
+	err := xml.Unmarshal(raw, parsed.Interface())
+	if err != nil {
+		return nil, err
+	}
+	src := &configSource{
+		raw:      raw,
+		parsed:   parsed.Elem(),
+		children: make(map[string]configSpan),
+		sections: make(map[string]*configSection),
+	}
+	items := make(map[string]string)
+	for _, field := range configFields(typ) {
+		if field.section {
+			items[field.name] = field.item
+		}
+	}
+
+	var open []configSpan
+	var names []string
+	lead := -1
+	d := xml.NewDecoder(bytes.NewReader(raw))
+	for {
+		offset := d.InputOffset()
+		tok, err := d.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		switch t := tok.(type) {
+		case xml.CharData:
+			if len(bytes.TrimSpace(t)) > 0 {
+				lead = -1
+			} else if lead < 0 {
+				lead = int(offset)
+			}
+			continue
+		case xml.StartElement:
+			span := configSpan{lead: int(offset), start: int(offset), contentStart: int(d.InputOffset())}
+			if lead >= 0 {
+				span.lead = lead
+			}
+			open = append(open, span)
+			names = append(names, t.Name.Local)
+		case xml.EndElement:
+			span, name := open[len(open)-1], names[len(names)-1]
+			open, names = open[:len(open)-1], names[:len(names)-1]
+			span.contentEnd, span.end = int(offset), int(d.InputOffset())
+			span.selfClosing = span.contentEnd == span.end
+			switch len(open) {
+			case 0:
+				src.root = span
+			case 1:
+				if src.lastChildEnd == 0 {
+					src.indent = string(raw[span.lead:span.start])
+				}
+				src.lastChildEnd = span.end
+				if _, ok := src.children[name]; !ok {
+					src.children[name] = span
+				}
+				if _, ok := items[name]; ok {
+					src.section(name).configSpan = span
+				}
+			case 2:
+				if item, ok := items[names[1]]; ok && item == name {
+					section := src.section(names[1])
+					section.items = append(section.items, span)
+				}
+			}
+		}
+		lead = -1
+	}
+	return src, nil
+}
+
+// section returns the section of a wrapper element, it is created on first use
+func (src *configSource) section(name string) *configSection {
+	section, ok := src.sections[name]
+	if !ok {
+		section = &configSection{}
+		src.sections[name] = section
+	}
+	return section
+}
+
+// patch writes v by applying its edits to the source.
+// ok is false if v was changed in a way that needs it encoded again, like an edited attribute.
+func (src *configSource) patch(v reflect.Value) (body []byte, ok bool, err error) {
+	var edits []configEdit
+	for _, field := range configFields(v.Type()) {
+		current, parsed := v.Field(field.index), src.parsed.Field(field.index)
+		switch {
+		case field.leaf:
+			if current.Interface() == parsed.Interface() {
+				continue
+			}
+			edit, ok := src.editLeaf(field.name, current)
+			if !ok {
+				return nil, false, nil
+			}
+			edits = append(edits, edit)
+		case field.section:
+			edit, changed, err := src.editSection(field, current)
+			if err != nil {
+				return nil, false, err
+			}
+			if changed {
+				edits = append(edits, edit)
+			}
+		default:
+			if !reflect.DeepEqual(current.Interface(), parsed.Interface()) {
+				return nil, false, nil
+			}
+		}
+	}
+
+	sort.SliceStable(edits, func(i, j int) bool { return edits[i].start < edits[j].start })
+	var buf bytes.Buffer
+	last := 0
+	for _, edit := range edits {
+		buf.Write(src.raw[last:edit.start])
+		buf.Write(edit.text)
+		last = edit.end
+	}
+	buf.Write(src.raw[last:])
+	return buf.Bytes(), true, nil
+}
+
+// editLeaf replaces the text of a string or bool child element, a missing element is appended
+func (src *configSource) editLeaf(name string, value reflect.Value) (configEdit, bool) {
+	var text bytes.Buffer
+	if value.Kind() == reflect.Bool {
+		text.WriteString(strconv.FormatBool(value.Bool()))
+	} else {
+		xml.EscapeText(&text, []byte(value.String()))
+	}
+
+	span, ok := src.children[name]
+	switch {
+	case ok && !span.selfClosing:
+		return configEdit{start: span.contentStart, end: span.contentEnd, text: text.Bytes()}, true
+	case ok:
+		return configEdit{start: span.start, end: span.end, text: []byte("<" + name + ">" + text.String() + "</" + name + ">")}, true
+	case src.root.selfClosing:
+		return configEdit{}, false
+	}
+	at := src.lastChildEnd
+	if at == 0 {
+		at = src.root.contentStart
+	}
+	return configEdit{start: at, end: at, text: []byte(src.indent + "<" + name + ">" + text.String() + "</" + name + ">")}, true
+}
+
+// editSection rewrites a section if items were edited, added, removed or reordered.
+// Unedited items keep their source, the whitespace between items is taken from the first one.
+func (src *configSource) editSection(field configField, items reflect.Value) (configEdit, bool, error) {
+	section := src.sections[field.name]
+	changed := section == nil || items.Len() != len(section.sources) || len(section.sources) != len(section.items)
+
+	sep := "\n"
+	if section != nil && len(section.items) > 0 {
+		sep = string(src.raw[section.items[0].lead:section.items[0].start])
+	} else if i := strings.LastIndex(src.indent, "\n"); i >= 0 {
+		sep = src.indent + src.indent[i+1:]
+	}
+
+	written := make([][]byte, items.Len())
+	for i := range written {
+		item := items.Index(i)
+		if itemSrc := item.Addr().Interface().(configSourced).configSource(); itemSrc != nil {
+			body, ok, err := itemSrc.patch(item)
+			if err != nil {
+				return configEdit{}, false, err
+			}
+			if ok {
+				written[i] = body
+				changed = changed || section.sources[i] != itemSrc || !bytes.Equal(body, itemSrc.raw)
+				continue
+			}
+		}
+		body, err := encodeConfigItem(item.Interface(), field.item, sep)
+		if err != nil {
+			return configEdit{}, false, err
+		}
+		written[i] = body
+		changed = true
+	}
+	if !changed {
+		return configEdit{}, false, nil
+	}
+
+	var buf bytes.Buffer
+	switch {
+	case len(written) == 0 && section == nil:
+		return configEdit{}, false, nil
+	case len(written) == 0:
+		return configEdit{start: section.lead, end: section.end}, true, nil
+	case section != nil && len(section.items) > 0:
+		buf.Write(src.raw[section.start:section.items[0].lead])
+		for _, body := range written {
+			buf.WriteString(sep)
+			buf.Write(body)
+		}
+		buf.Write(src.raw[section.items[len(section.items)-1].end:section.end])
+		return configEdit{start: section.start, end: section.end, text: buf.Bytes()}, true, nil
+	}
+
+	buf.WriteString(src.indent + "<" + field.name + ">")
+	for _, body := range written {
+		buf.WriteString(sep)
+		buf.Write(body)
+	}
+	buf.WriteString(src.indent + "</" + field.name + ">")
+	if section != nil {
+		return configEdit{start: section.lead, end: section.end, text: buf.Bytes()}, true, nil
+	}
+	at := src.lastChildEnd
+	if at == 0 {
+		at = src.root.contentStart
+	}
+	return configEdit{start: at, end: at, text: buf.Bytes()}, true, nil
+}
+
+// encodeConfigItem encodes an item without a source, indented like the items around it
+func encodeConfigItem(item interface{}, name string, sep string) ([]byte, error) {
+	var buf bytes.Buffer
+	enc := xml.NewEncoder(&buf)
+	prefix := ""
+	if i := strings.LastIndex(sep, "\n"); i >= 0 {
+		prefix = sep[i+1:]
+		enc.Indent(prefix, "    ")
+	}
+	err := enc.EncodeElement(item, xml.StartElement{Name: xml.Name{Local: name}})
+	if err != nil {
+		return nil, err
+	}
+	return bytes.TrimPrefix(buf.Bytes(), []byte(prefix)), nil
+}
+
+func (c *ApplicationConfig) configSource() *configSource       { return c.source }
+func (c *ApplicationConfig) setConfigSource(src *configSource) { c.source = src }
+
+func (t *ConfigTier) configSource() *configSource       { return t.source }
+func (t *ConfigTier) setConfigSource(src *configSource) { t.source = src }
+
+func (bt *ConfigBusinessTransaction) configSource() *configSource       { return bt.source }
+func (bt *ConfigBusinessTransaction) setConfigSource(src *configSource) { bt.source = src }
+
+func (mp *ConfigCustomMatchPoint) configSource() *configSource       { return mp.source }
+func (mp *ConfigCustomMatchPoint) setConfigSource(src *configSource) { mp.source = src }
+
+func (ec *ConfigErrorConfiguration) configSource() *configSource       { return ec.source }
+func (ec *ConfigErrorConfiguration) setConfigSource(src *configSource) { ec.source = src }
+
+func (dc *ConfigDataCollector) configSource() *configSource       { return dc.source }
+func (dc *ConfigDataCollector) setConfigSource(src *configSource) { dc.source = src }
+
+func (p *ConfigPolicy) configSource() *configSource       { return p.source }
+func (p *ConfigPolicy) setConfigSource(src *configSource) { p.source = src }
@@ -0,0 +1,169 @@
+/*
+MIT License
+
+Copyright (c) 2026 Cisco Systems, Inc. and its affiliates
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+
+*/
+
+package appdrest
+
+import (
+	"os"
+	"strings"
+	"testing"
+)
+
+func readConfigFixture(t *testing.T) (string, *ApplicationConfig) {
+	t.Helper()
+	body, err := os.ReadFile("testdata/application-export.xml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	config, err := ParseApplicationConfig(body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(body), config
+}
+
+func TestApplicationConfigRoundTrip(t *testing.T) {
+	body, config := readConfigFixture(t)
+	if len(config.Tiers) != 2 || len(config.BusinessTransactions) != 2 || len(config.CustomMatchPoints) != 1 || len(config.Other) != 1 {
+		t.Fatalf("parsed %+v", config)
+	}
+	got, err := config.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(got) != body {
+		t.Errorf("Marshal changed the unedited export:\n%s", got)
+	}
+}
+
+func TestApplicationConfigEdits(t *testing.T) {
+	const inventoryTier = `
+        <application-component>
+            <name>Inventory</name>
+            <description></description>
+            <component-type>DOT_NET_APPLICATION_SERVER</component-type>
+        </application-component>`
+
+	tests := []struct {
+		name    string
+		edit    func(c *ApplicationConfig)
+		replace []string // old, new pairs applied to the fixture
+	}{
+		{
+			"rename tier",
+			func(c *ApplicationConfig) { c.RenameTier("Web", "Store<front>") },
+			[]string{
+				"<name>Web</name>", "<name>Store&lt;front&gt;</name>",
+				"<application-component>Web</application-component>", "<application-component>Store&lt;front&gt;</application-component>",
+			},
+		},
+		{
+			"self-closing description",
+			func(c *ApplicationConfig) { c.Description = "shop & payments" },
+			[]string{"<description/>", "<description>shop &amp; payments</description>"},
+		},
+		{
+			"missing bool",
+			func(c *ApplicationConfig) { c.BusinessTransactions[1].Background = true },
+			[]string{
+				"<entry-point-type>ASP_DOTNET</entry-point-type>",
+				"<entry-point-type>ASP_DOTNET</entry-point-type>\n            <background>true</background>",
+			},
+		},
+		{
+			"removed tier",
+			func(c *ApplicationConfig) { c.Tiers = c.Tiers[:1] },
+			[]string{inventoryTier, ""},
+		},
+		{
+			"reordered tiers",
+			func(c *ApplicationConfig) { c.Tiers[0], c.Tiers[1] = c.Tiers[1], c.Tiers[0] },
+			[]string{
+				inventoryTier, "",
+				"<application-components>", "<application-components>" + inventoryTier,
+			},
+		},
+		{
+			"added policy",
+			func(c *ApplicationConfig) {
+				c.Policies = append(c.Policies, ConfigPolicy{Name: "page on-call", Enabled: true})
+			},
+			[]string{
+				"<policies/>",
+				"<policies>\n        <policy>\n            <name>page on-call</name>\n            <enabled>true</enabled>\n        </policy>\n    </policies>",
+			},
+		},
+		{
+			"edited attribute",
+			func(c *ApplicationConfig) { c.ErrorConfigurations[0].AgentType = "NODEJS_APP_AGENT" },
+			[]string{
+				`<error-configuration agent-type="APP_AGENT">
+            <ignore-exceptions/>
+            <mark-bt-as-error>true</mark-bt-as-error>
+        </error-configuration>`,
+				`<error-configuration agent-type="NODEJS_APP_AGENT">
+            <ignore-exceptions></ignore-exceptions>
+            <mark-bt-as-error>true</mark-bt-as-error>
+        </error-configuration>`,
+			},
+		},
+	}
+	for _, test := range tests {
+		body, config := readConfigFixture(t)
+		test.edit(config)
+		got, err := config.Marshal()
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := body
+		for i := 0; i < len(test.replace); i += 2 {
+			if !strings.Contains(want, test.replace[i]) {
+				t.Fatalf("%s: fixture does not contain %q", test.name, test.replace[i])
+			}
+			want = strings.Replace(want, test.replace[i], test.replace[i+1], -1)
+		}
+		if string(got) != want {
+			t.Errorf("%s: Marshal wrote\n%s\nwant\n%s", test.name, got, want)
+		}
+		if _, err := ParseApplicationConfig(got); err != nil {
+			t.Errorf("%s: %v", test.name, err)
+		}
+	}
+}
+
+func TestApplicationConfigMarshalBuilt(t *testing.T) {
+	config := &ApplicationConfig{Name: "shop", Tiers: []ConfigTier{{Name: "web"}}}
+	body, err := config.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	parsed, err := ParseApplicationConfig(body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if parsed.Name != "shop" || len(parsed.Tiers) != 1 || parsed.Tiers[0].Name != "web" || parsed.Policies != nil {
+		t.Errorf("built configuration parsed as %+v", parsed)
+	}
+}
@@ -0,0 +1,73 @@
+/*
+MIT License
+
+Copyright (c) 2026 Cisco Systems, Inc. and its affiliates
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+
+*/
+
+package appdrest
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+)
+
+func TestCreateApplicationExists(t *testing.T) {
+	var created int
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/controller/rest/applications":
+			w.Write([]byte(`[{"id":7,"name":"shop"}]`))
+		case "/controller/restui/allApplications/createApplication":
+			created++
+			w.Write([]byte(`{"id":8,"name":"billing"}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+		}
+	}))
+
+	app, err := client.Application.CreateApplication("shop", "")
+	if !errors.Is(err, ErrApplicationExists) || app != nil {
+		t.Errorf("CreateApplication(shop) = %v, %v, want nil, %v", app, err, ErrApplicationExists)
+	}
+
+	tests := []struct {
+		name        string
+		wantID      int
+		wantCreated bool
+	}{
+		{"shop", 7, false},
+		{"billing", 8, true},
+	}
+	for _, test := range tests {
+		app, isNew, err := client.Application.GetOrCreateApplication(test.name, "")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if app.ID != test.wantID || isNew != test.wantCreated {
+			t.Errorf("GetOrCreateApplication(%s) = %d, %t, want %d, %t", test.name, app.ID, isNew, test.wantID, test.wantCreated)
+		}
+	}
+	if created != 1 {
+		t.Errorf("%d applications created, want 1", created)
+	}
+}
@@ -26,22 +26,96 @@ SOFTWARE.
 package appdrest
 
 import (
+	"errors"
 	"fmt"
+	"regexp"
 	"strconv"
 )
 
 // Backend represents a single Backend within AppDynamics application
 // Note that the REST version only has ID, Name and Description
+// Modified by 2026 Cisco Systems, Inc.
 type Backend struct {
-	ID                         int    `json:"id"`
-	TierID                     int    `json:"tierId"`
-	Name                       string `json:"name"`
-	ApplicationComponentNodeID int    `json:"applicationComponentNodeId"`
-	Properties                 []struct {
-		Name  string `json:"name"`
-		ID    int    `json:"id"`
-		Value string `json:"value"`
-	} `json:"properties"`
+	ID                         int               `json:"id"`
+	TierID                     int               `json:"tierId"`
+	Name                       string            `json:"name"`
+	ExitPointType              string            `json:"exitPointType"`
+	ApplicationComponentNodeID int               `json:"applicationComponentNodeId"`
+	Properties                 []BackendProperty `json:"properties"`
+}
+
+// BackendProperty is one identifying property of a backend, e.g. HOST or PORT
+// Added 2026 Cisco Systems, Inc.
+type BackendProperty struct {
+	Name  string `json:"name"`
+	ID    int    `json:"id"`
+	Value string `json:"value"`
+}
+
+// Consts for the common backend property names
+// Added 2026 Cisco Systems, Inc.
+const (
+	BackendPropertyHost     = "HOST"
+	BackendPropertyPort     = "PORT"
+	BackendPropertyURL      = "URL"
+	BackendPropertyDatabase = "DATABASE"
+	BackendPropertyVendor   = "VENDOR"
+)
+
+// Property returns the value of a property and whether the backend has it
+// Added 2026 Cisco Systems, Inc.
+func (b *Backend) Property(name string) (string, bool) {
+	for _, property := range b.Properties {
+		if property.Name == name {
+			return property.Value, true
+		}
+	}
+	return "", false
+}
+
+func (b *Backend) property(name string) string {
+	value, _ := b.Property(name)
+	return value
+}
+
+// Host returns the HOST property or an empty string
+// Added 2026 Cisco Systems, Inc.
+func (b *Backend) Host() string {
+	return b.property(BackendPropertyHost)
+}
+
+// Port returns the PORT property or 0 when it is missing or not a number
+// Added 2026 Cisco Systems, Inc.
+func (b *Backend) Port() int {
+	port, err := strconv.Atoi(b.property(BackendPropertyPort))
+	if err != nil {
+		return 0
+	}
+	return port
+}
+
+// URL returns the URL property or an empty string
+// Added 2026 Cisco Systems, Inc.
+func (b *Backend) URL() string {
+	return b.property(BackendPropertyURL)
+}
+
+// Database returns the DATABASE property or an empty string
+// Added 2026 Cisco Systems, Inc.
+func (b *Backend) Database() string {
+	return b.property(BackendPropertyDatabase)
+}
+
+// Vendor returns the VENDOR property or an empty string
+// Added 2026 Cisco Systems, Inc.
+func (b *Backend) Vendor() string {
+	return b.property(BackendPropertyVendor)
+}
+
+// Resolved reports whether the backend is resolved to a tier
+// Added 2026 Cisco Systems, Inc.
+func (b *Backend) Resolved() bool {
+	return b.TierID > 0
 }
 
 // BackendService intermediates Application requests
@@ -61,6 +135,23 @@ func (s *BackendService) GetBackends(app string) ([]*Backend, error) {
 	return backends, nil
 }
 
+// GetBackendsByProperty obtains the backends of an application having a property with the given value
+// Added 2026 Cisco Systems, Inc.
+func (s *BackendService) GetBackendsByProperty(app string, name string, value string) ([]*Backend, error) {
+	backends, err := s.GetBackends(app)
+	if err != nil {
+		return nil, err
+	}
+
+	var found []*Backend
+	for _, backend := range backends {
+		if v, ok := backend.Property(name); ok && v == value {
+			found = append(found, backend)
+		}
+	}
+	return found, nil
+}
+
 // ResolveBackendToTier - resolves Backend to an application Tier
 // It might break in future versions of AppDynamics
 func (s *BackendService) ResolveBackendToTier(backendID int, tierID int) error {
@@ -81,19 +172,169 @@ func (s *BackendService) ResolveBackendToTier(backendID int, tierID int) error {
 	return nil
 }
 
-// UnresolveBackendToTier - resolves Backend to an application Tier
+// UnresolveBackendToTier - reverts ResolveBackendToTier, the backend is shown as a backend again
 // It might break in future versions of AppDynamics
-// !!! this is unfinished !!! - explore how to send body request with POST
-// in a form [<<backendID>>]
+// Modified by 2026 Cisco Systems, Inc.
 func (s *BackendService) UnresolveBackendToTier(backendID int) error {
 
-	url := fmt.Sprintf("controller/restui/backendUiService/deleteBackends")
+	url := "controller/restui/backendUiService/unresolveBackends"
 
 	body := []int{backendID}
 	err := s.client.RestInternal("POST", url, nil, &body)
 	if err != nil {
+		if fmt.Sprintf("%s", err) == "EOF" { // successful call returns EOF error -> empty body
+			return nil
+		}
 		return err
 	}
 
 	return nil
 }
+
+// DANGER ZONE
+// following types are used for UNPUBLISHED api call and it may change in the future
+type backendRename struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+// DANGER ZONE END
+
+// DANGER ZONE
+// this is an UNPUBLISHED API call - it may change in the future
+
+// RenameBackend changes the display name of a backend
+// Added 2026 Cisco Systems, Inc.
+func (s *BackendService) RenameBackend(backendID int, name string) error {
+
+	if name == "" {
+		return errors.New("empty backend name")
+	}
+
+	url := "controller/restui/backendUiService/renameBackend"
+
+	body := backendRename{ID: backendID, Name: name}
+	err := s.client.RestInternal("POST", url, nil, &body)
+	if err != nil {
+		if fmt.Sprintf("%s", err) == "EOF" { // successful call returns EOF error -> empty body
+			return nil
+		}
+		return err
+	}
+
+	return nil
+}
+
+// DeleteBackends deletes backends with their metrics, backends that are still called are discovered again
+// Added 2026 Cisco Systems, Inc.
+func (s *BackendService) DeleteBackends(backendIDs []int) error {
+
+	if len(backendIDs) == 0 {
+		return errors.New("no backends given")
+	}
+
+	url := "controller/restui/backendUiService/deleteBackends"
+
+	err := s.client.RestInternal("POST", url, nil, &backendIDs)
+	if err != nil {
+		if fmt.Sprintf("%s", err) == "EOF" { // successful call returns EOF error -> empty body
+			return nil
+		}
+		return err
+	}
+
+	return nil
+}
+
+// DeleteBackend deletes a single backend
+// Added 2026 Cisco Systems, Inc.
+func (s *BackendService) DeleteBackend(backendID int) error {
+	return s.DeleteBackends([]int{backendID})
+}
+
+// DANGER ZONE END
+
+// BackendFilter selects backends for bulk operations, empty fields match everything.
+// DeleteMatching refuses a filter without any criteria unless All is set.
+// Added 2026 Cisco Systems, Inc.
+type BackendFilter struct {
+	ExitPointTypes []string          // e.g. "HTTP", "JDBC", "CACHE"
+	Name           *regexp.Regexp    // backend name pattern
+	Host           *regexp.Regexp    // HOST property pattern, backends without HOST never match
+	Properties     map[string]string // exact property values
+	Resolved       *bool             // only backends resolved or not resolved to a tier
+	All            bool              // explicitly select every backend when no other field is set
+}
+
+// Empty reports whether the filter has no criteria and All is not set
+func (f BackendFilter) Empty() bool {
+	return !f.All && len(f.ExitPointTypes) == 0 && f.Name == nil && f.Host == nil && len(f.Properties) == 0 && f.Resolved == nil
+}
+
+// Match reports whether a backend is selected by the filter
+func (f BackendFilter) Match(backend *Backend) bool {
+	if len(f.ExitPointTypes) > 0 && !containsString(f.ExitPointTypes, backend.ExitPointType) {
+		return false
+	}
+	if f.Name != nil && !f.Name.MatchString(backend.Name) {
+		return false
+	}
+	if f.Host != nil {
+		host, ok := backend.Property(BackendPropertyHost)
+		if !ok || !f.Host.MatchString(host) {
+			return false
+		}
+	}
+	for name, value := range f.Properties {
+		if v, ok := backend.Property(name); !ok || v != value {
+			return false
+		}
+	}
+	if f.Resolved != nil && *f.Resolved != backend.Resolved() {
+		return false
+	}
+	return true
+}
+
+// SelectBackends returns the backends of an application matching the filter
+// Added 2026 Cisco Systems, Inc.
+func (s *BackendService) SelectBackends(app string, filter BackendFilter) ([]*Backend, error) {
+	backends, err := s.GetBackends(app)
+	if err != nil {
+		return nil, err
+	}
+
+	var selected []*Backend
+	for _, backend := range backends {
+		if filter.Match(backend) {
+			selected = append(selected, backend)
+		}
+	}
+	return selected, nil
+}
+
+// BackendIDs returns the IDs of the backends
+// Added 2026 Cisco Systems, Inc.
+func BackendIDs(backends []*Backend) []int {
+	ids := make([]int, len(backends))
+	for i, backend := range backends {
+		ids[i] = backend.ID
+	}
+	return ids
+}
+
+// DeleteMatching deletes all backends matching the filter and returns them.
+// With dryRun the backends are only selected, e.g. to review
+// BackendFilter{Host: regexp.MustCompile(`^test-.*\.example\.com$`)} before deleting.
+// An empty filter returns ErrEmptyFilter, also with dryRun.
+// Added 2026 Cisco Systems, Inc.
+func (s *BackendService) DeleteMatching(app string, filter BackendFilter, dryRun bool) ([]*Backend, error) {
+	if filter.Empty() {
+		return nil, ErrEmptyFilter
+	}
+	backends, err := s.SelectBackends(app, filter)
+	if err != nil || len(backends) == 0 || dryRun {
+		return backends, err
+	}
+	return backends, s.DeleteBackends(BackendIDs(backends))
+}
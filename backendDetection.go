@@ -0,0 +1,380 @@
+/*
+MIT License
+
+Copyright (c) 2026 Cisco Systems, Inc. and its affiliates
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+
+*/
+
+package appdrest
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"net/url"
+)
+
+// ErrBackendDetectionRuleNotFound is returned when a discovery rule or custom exit point does not exist
+var ErrBackendDetectionRuleNotFound = errors.New("backend detection rule not found")
+
+// ErrBackendDetectionRuleExists is returned when creating a discovery rule or custom exit point with a taken name
+var ErrBackendDetectionRuleExists = errors.New("backend detection rule already exists")
+
+// DANGER ZONE
+// following types are used for UNPUBLISHED api call and it may change in the future,
+// the element and attribute names are not verified against a controller export yet
+// BackendMatchOperator compares a backend property with a value
+type BackendMatchOperator string
+
+// Consts for the match operators of discovery rule conditions
+const (
+	BackendMatchEquals     BackendMatchOperator = "EQUALS"
+	BackendMatchStartsWith BackendMatchOperator = "STARTS_WITH"
+	BackendMatchEndsWith   BackendMatchOperator = "ENDS_WITH"
+	BackendMatchContains   BackendMatchOperator = "CONTAINS"
+	BackendMatchRegex      BackendMatchOperator = "MATCHES_REGEX"
+)
+
+// BackendURLSegments selects which segments of a URL are part of the backend name
+type BackendURLSegments string
+
+// Consts for the URL segment selections of the naming configuration
+const (
+	BackendURLFirstSegments  BackendURLSegments = "FIRST_N_SEGMENTS"
+	BackendURLLastSegments   BackendURLSegments = "LAST_N_SEGMENTS"
+	BackendURLSegmentNumbers BackendURLSegments = "SEGMENT_NUMBERS"
+)
+
+// BackendMatchCondition restricts a discovery rule to backends with matching properties
+type BackendMatchCondition struct {
+	Property string               `xml:"property,attr"`
+	Operator BackendMatchOperator `xml:"operator,attr"`
+	Value    string               `xml:"value,attr"`
+	Negate   bool                 `xml:"negate,attr,omitempty"`
+	Attrs    []xml.Attr           `xml:",any,attr"`
+}
+
+// BackendNamingProperty decides whether a property is part of the backend name.
+// Segments only apply to URL like properties.
+type BackendNamingProperty struct {
+	Name      string             `xml:"name,attr"` // e.g. BackendPropertyHost
+	Enabled   bool               `xml:"enabled,attr"`
+	Segments  BackendURLSegments `xml:"segments,attr,omitempty"`
+	Count     int                `xml:"segment-count,attr,omitempty"`
+	Numbers   string             `xml:"segment-numbers,attr,omitempty"` // comma separated, for BackendURLSegmentNumbers
+	Delimiter string             `xml:"delimiter,attr,omitempty"`
+	Attrs     []xml.Attr         `xml:",any,attr"`
+}
+
+// BackendNamingConfig lists the properties backends are identified and named by,
+// disabling HOST for HTTP backends collapses all hosts into one backend
+type BackendNamingConfig struct {
+	Attrs      []xml.Attr              `xml:",any,attr"`
+	Properties []BackendNamingProperty `xml:"property"`
+	Other      []ConfigElement         `xml:",any"`
+}
+
+// Property returns the naming configuration of a property or nil
+func (c *BackendNamingConfig) Property(name string) *BackendNamingProperty {
+	for i := range c.Properties {
+		if c.Properties[i].Name == name {
+			return &c.Properties[i]
+		}
+	}
+	return nil
+}
+
+// BackendDiscoveryRule controls discovery and naming of the backends of one exit point type.
+// Like ApplicationConfig, attributes and elements without a typed field are kept in Attrs and Other,
+// so exporting, editing and importing a scope does not drop settings of newer controllers.
+type BackendDiscoveryRule struct {
+	Name            string                  `xml:"name,attr"`
+	AgentType       AgentType               `xml:"agent-type,attr"`
+	ExitPointType   string                  `xml:"exit-point-type,attr"` // e.g. "HTTP", "JDBC", "JMS"
+	Enabled         bool                    `xml:"enabled,attr"`
+	Priority        int                     `xml:"priority,attr"`
+	Discover        bool                    `xml:"discover,attr"`    // false hides matching backends
+	Correlation     bool                    `xml:"correlation,attr"` // continue transactions into the called tier
+	MatchConditions []BackendMatchCondition `xml:"match-conditions>condition"`
+	Naming          BackendNamingConfig     `xml:"naming-config"`
+	Attrs           []xml.Attr              `xml:",any,attr"`
+	Other           []ConfigElement         `xml:",any"`
+}
+
+// BackendDiscoveryRules is the discovery configuration of an application or a tier
+type BackendDiscoveryRules struct {
+	XMLName           xml.Name               `xml:"backend-discovery-rules"`
+	ControllerVersion string                 `xml:"controller-version,attr,omitempty"`
+	Attrs             []xml.Attr             `xml:",any,attr"`
+	Rules             []BackendDiscoveryRule `xml:"backend-discovery-rule"`
+	Other             []ConfigElement        `xml:",any"`
+}
+
+// CustomExitPointIdentifier names a backend of a custom exit point from data of the intercepted call
+type CustomExitPointIdentifier struct {
+	Property    string          `xml:"property,attr"`
+	Source      string          `xml:"source,attr"`                 // INVOKED_OBJECT, PARAMETER or RETURN_VALUE
+	Index       int             `xml:"index,attr,omitempty"`        // parameter index for PARAMETER
+	GetterChain string          `xml:"getter-chain,attr,omitempty"` // e.g. "getConfig().getHost()"
+	Attrs       []xml.Attr      `xml:",any,attr"`
+	Other       []ConfigElement `xml:",any"`
+}
+
+// CustomExitPoint detects calls to a method as calls to a backend
+type CustomExitPoint struct {
+	Name          string                      `xml:"name,attr"`
+	AgentType     AgentType                   `xml:"agent-type,attr"`
+	ExitPointType string                      `xml:"exit-point-type,attr"` // backend type shown on the flow map, e.g. "CACHE"
+	Enabled       bool                        `xml:"enabled,attr"`
+	MatchType     string                      `xml:"match-type,attr"` // MATCHES_CLASS, IMPLEMENTS_INTERFACE, EXTENDS_CLASS, ...
+	ClassName     string                      `xml:"class-name,attr"`
+	MethodName    string                      `xml:"method-name,attr"`
+	Identifiers   []CustomExitPointIdentifier `xml:"identifiers>identifier"`
+	Attrs         []xml.Attr                  `xml:",any,attr"`
+	Other         []ConfigElement             `xml:",any"`
+}
+
+// CustomExitPoints are the custom exit points of an application or a tier
+type CustomExitPoints struct {
+	XMLName           xml.Name          `xml:"custom-exit-points"`
+	ControllerVersion string            `xml:"controller-version,attr,omitempty"`
+	Attrs             []xml.Attr        `xml:",any,attr"`
+	ExitPoints        []CustomExitPoint `xml:"custom-exit-point"`
+	Other             []ConfigElement   `xml:",any"`
+}
+
+// BackendDetection bundles the discovery rules and custom exit points of an application or a tier for export
+type BackendDetection struct {
+	XMLName          xml.Name              `xml:"backend-detection"`
+	Tier             string                `xml:"tier,attr,omitempty"` // empty for the application scope
+	DiscoveryRules   BackendDiscoveryRules `xml:"backend-discovery-rules"`
+	CustomExitPoints CustomExitPoints      `xml:"custom-exit-points"`
+}
+
+// DANGER ZONE END
+
+// BackendDetectionService intermediates backend discovery rule and custom exit point requests.
+// An empty tier addresses the application scope, a tier name the configuration of that tier.
+// Imports replace the whole configuration of a scope, single rules are changed with export, edit and import.
+// The endpoints follow the transaction detection import and export and are not verified against a controller yet.
+type BackendDetectionService service
+
+func backendDetectionURL(appID int, tier string, kind string) string {
+	if tier == "" {
+		return fmt.Sprintf("controller/transactiondetection/%d/%s", appID, kind)
+	}
+	return fmt.Sprintf("controller/transactiondetection/%d/%s/%s", appID, url.PathEscape(tier), kind)
+}
+
+func (s *BackendDetectionService) export(appID int, tier string, kind string, v interface{}) error {
+	body, err := s.client.DoRawRequest("GET", backendDetectionURL(appID, tier, kind), nil)
+	if err != nil {
+		return err
+	}
+	return xml.Unmarshal(body, v)
+}
+
+func (s *BackendDetectionService) upload(appID int, tier string, kind string, v interface{}) error {
+	body, err := xml.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	// the servlet reports rejected files in a plain text answer with status 200
+	_, err = s.client.uploadFile(backendDetectionURL(appID, tier, kind), kind+".xml", body)
+	return err
+}
+
+// GetDiscoveryRules obtains the backend discovery rules of an application or tier
+// DANGER ZONE
+// this is an UNPUBLISHED API call - it may change in the future
+func (s *BackendDetectionService) GetDiscoveryRules(appID int, tier string) (*BackendDiscoveryRules, error) {
+	var rules BackendDiscoveryRules
+	err := s.export(appID, tier, "backend", &rules)
+	if err != nil {
+		return nil, err
+	}
+	return &rules, nil
+}
+
+// ImportDiscoveryRules replaces the backend discovery rules of an application or tier
+// DANGER ZONE
+// this is an UNPUBLISHED API call - it may change in the future
+func (s *BackendDetectionService) ImportDiscoveryRules(appID int, tier string, rules *BackendDiscoveryRules) error {
+	return s.upload(appID, tier, "backend", rules)
+}
+
+// CreateDiscoveryRule adds a backend discovery rule to an application or tier
+// DANGER ZONE
+// this is an UNPUBLISHED API call - it may change in the future
+func (s *BackendDetectionService) CreateDiscoveryRule(appID int, tier string, rule BackendDiscoveryRule) error {
+	rules, err := s.GetDiscoveryRules(appID, tier)
+	if err != nil {
+		return err
+	}
+	if findDiscoveryRule(rules, rule.Name, rule.ExitPointType) >= 0 {
+		return fmt.Errorf("%s/%s: %w", rule.ExitPointType, rule.Name, ErrBackendDetectionRuleExists)
+	}
+	rules.Rules = append(rules.Rules, rule)
+	return s.ImportDiscoveryRules(appID, tier, rules)
+}
+
+// UpdateDiscoveryRule replaces the backend discovery rule with the same name and exit point type
+// DANGER ZONE
+// this is an UNPUBLISHED API call - it may change in the future
+func (s *BackendDetectionService) UpdateDiscoveryRule(appID int, tier string, rule BackendDiscoveryRule) error {
+	rules, err := s.GetDiscoveryRules(appID, tier)
+	if err != nil {
+		return err
+	}
+	i := findDiscoveryRule(rules, rule.Name, rule.ExitPointType)
+	if i < 0 {
+		return fmt.Errorf("%s/%s: %w", rule.ExitPointType, rule.Name, ErrBackendDetectionRuleNotFound)
+	}
+	rules.Rules[i] = rule
+	return s.ImportDiscoveryRules(appID, tier, rules)
+}
+
+// DeleteDiscoveryRule removes a backend discovery rule from an application or tier
+// DANGER ZONE
+// this is an UNPUBLISHED API call - it may change in the future
+func (s *BackendDetectionService) DeleteDiscoveryRule(appID int, tier string, exitPointType string, name string) error {
+	rules, err := s.GetDiscoveryRules(appID, tier)
+	if err != nil {
+		return err
+	}
+	i := findDiscoveryRule(rules, name, exitPointType)
+	if i < 0 {
+		return fmt.Errorf("%s/%s: %w", exitPointType, name, ErrBackendDetectionRuleNotFound)
+	}
+	rules.Rules = append(rules.Rules[:i], rules.Rules[i+1:]...)
+	return s.ImportDiscoveryRules(appID, tier, rules)
+}
+
+func findDiscoveryRule(rules *BackendDiscoveryRules, name string, exitPointType string) int {
+	for i, rule := range rules.Rules {
+		if rule.Name == name && rule.ExitPointType == exitPointType {
+			return i
+		}
+	}
+	return -1
+}
+
+// GetCustomExitPoints obtains the custom exit points of an application or tier
+// DANGER ZONE
+// this is an UNPUBLISHED API call - it may change in the future
+func (s *BackendDetectionService) GetCustomExitPoints(appID int, tier string) (*CustomExitPoints, error) {
+	var exitPoints CustomExitPoints
+	err := s.export(appID, tier, "custom-exit", &exitPoints)
+	if err != nil {
+		return nil, err
+	}
+	return &exitPoints, nil
+}
+
+// ImportCustomExitPoints replaces the custom exit points of an application or tier
+// DANGER ZONE
+// this is an UNPUBLISHED API call - it may change in the future
+func (s *BackendDetectionService) ImportCustomExitPoints(appID int, tier string, exitPoints *CustomExitPoints) error {
+	return s.upload(appID, tier, "custom-exit", exitPoints)
+}
+
+// CreateCustomExitPoint adds a custom exit point to an application or tier
+// DANGER ZONE
+// this is an UNPUBLISHED API call - it may change in the future
+func (s *BackendDetectionService) CreateCustomExitPoint(appID int, tier string, exitPoint CustomExitPoint) error {
+	exitPoints, err := s.GetCustomExitPoints(appID, tier)
+	if err != nil {
+		return err
+	}
+	if findCustomExitPoint(exitPoints, exitPoint.Name) >= 0 {
+		return fmt.Errorf("%s: %w", exitPoint.Name, ErrBackendDetectionRuleExists)
+	}
+	exitPoints.ExitPoints = append(exitPoints.ExitPoints, exitPoint)
+	return s.ImportCustomExitPoints(appID, tier, exitPoints)
+}
+
+// UpdateCustomExitPoint replaces the custom exit point with the same name
+// DANGER ZONE
+// this is an UNPUBLISHED API call - it may change in the future
+func (s *BackendDetectionService) UpdateCustomExitPoint(appID int, tier string, exitPoint CustomExitPoint) error {
+	exitPoints, err := s.GetCustomExitPoints(appID, tier)
+	if err != nil {
+		return err
+	}
+	i := findCustomExitPoint(exitPoints, exitPoint.Name)
+	if i < 0 {
+		return fmt.Errorf("%s: %w", exitPoint.Name, ErrBackendDetectionRuleNotFound)
+	}
+	exitPoints.ExitPoints[i] = exitPoint
+	return s.ImportCustomExitPoints(appID, tier, exitPoints)
+}
+
+// DeleteCustomExitPoint removes a custom exit point from an application or tier
+// DANGER ZONE
+// this is an UNPUBLISHED API call - it may change in the future
+func (s *BackendDetectionService) DeleteCustomExitPoint(appID int, tier string, name string) error {
+	exitPoints, err := s.GetCustomExitPoints(appID, tier)
+	if err != nil {
+		return err
+	}
+	i := findCustomExitPoint(exitPoints, name)
+	if i < 0 {
+		return fmt.Errorf("%s: %w", name, ErrBackendDetectionRuleNotFound)
+	}
+	exitPoints.ExitPoints = append(exitPoints.ExitPoints[:i], exitPoints.ExitPoints[i+1:]...)
+	return s.ImportCustomExitPoints(appID, tier, exitPoints)
+}
+
+func findCustomExitPoint(exitPoints *CustomExitPoints, name string) int {
+	for i, exitPoint := range exitPoints.ExitPoints {
+		if exitPoint.Name == name {
+			return i
+		}
+	}
+	return -1
+}
+
+// ExportBackendDetection exports discovery rules and custom exit points of an application or tier in one document
+// DANGER ZONE
+// this is an UNPUBLISHED API call - it may change in the future
+func (s *BackendDetectionService) ExportBackendDetection(appID int, tier string) (*BackendDetection, error) {
+	rules, err := s.GetDiscoveryRules(appID, tier)
+	if err != nil {
+		return nil, err
+	}
+	exitPoints, err := s.GetCustomExitPoints(appID, tier)
+	if err != nil {
+		return nil, err
+	}
+	return &BackendDetection{Tier: tier, DiscoveryRules: *rules, CustomExitPoints: *exitPoints}, nil
+}
+
+// ImportBackendDetection imports a document written by ExportBackendDetection into the scope named by its tier
+// DANGER ZONE
+// this is an UNPUBLISHED API call - it may change in the future
+func (s *BackendDetectionService) ImportBackendDetection(appID int, detection *BackendDetection) error {
+	err := s.ImportDiscoveryRules(appID, detection.Tier, &detection.DiscoveryRules)
+	if err != nil {
+		return err
+	}
+	return s.ImportCustomExitPoints(appID, detection.Tier, &detection.CustomExitPoints)
+}
@@ -0,0 +1,93 @@
+/*
+MIT License
+
+Copyright (c) 2026 Cisco Systems, Inc. and its affiliates
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+
+*/
+
+package appdrest
+
+import (
+	"encoding/xml"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+const testDiscoveryRules = `<backend-discovery-rules controller-version="024-001-000">` +
+	`<backend-discovery-rule name="Default HTTP" agent-type="APP_AGENT" exit-point-type="HTTP" enabled="true" priority="0" discover="true" correlation="true" version="3">` +
+	`<match-conditions><condition property="HOST" operator="ENDS_WITH" value=".internal"></condition></match-conditions>` +
+	`<naming-config><property name="HOST" enabled="true" case-sensitive="false"></property></naming-config>` +
+	`<discovery-timeout>30</discovery-timeout>` +
+	`</backend-discovery-rule>` +
+	`</backend-discovery-rules>`
+
+func TestDiscoveryRulesRoundTrip(t *testing.T) {
+	var rules BackendDiscoveryRules
+	if err := xml.Unmarshal([]byte(testDiscoveryRules), &rules); err != nil {
+		t.Fatal(err)
+	}
+	rule := rules.Rules[0]
+	if rule.ExitPointType != "HTTP" || rule.MatchConditions[0].Value != ".internal" || rule.Naming.Property(BackendPropertyHost) == nil {
+		t.Fatalf("typed fields not parsed: %+v", rule)
+	}
+
+	body, err := xml.Marshal(rules)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, kept := range []string{`version="3"`, `case-sensitive="false"`, `<discovery-timeout>30</discovery-timeout>`} {
+		if !strings.Contains(string(body), kept) {
+			t.Errorf("%s lost in %s", kept, body)
+		}
+	}
+}
+
+func TestImportDiscoveryRulesRejected(t *testing.T) {
+	tests := []struct {
+		answer  string
+		wantErr bool
+	}{
+		{"Successfully imported 1 rules", false},
+		{"Error: unknown exit point type FOO", true},
+	}
+	for _, test := range tests {
+		client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != "POST" || r.URL.Path != "/controller/transactiondetection/1/web/backend" {
+				t.Errorf("got %s %s", r.Method, r.URL.Path)
+			}
+			file, _, err := r.FormFile("file")
+			if err != nil {
+				t.Fatal(err)
+			}
+			body, _ := io.ReadAll(file)
+			if !strings.HasPrefix(string(body), "<backend-discovery-rules") {
+				t.Errorf("uploaded %s", body)
+			}
+			io.WriteString(w, test.answer)
+		}))
+		err := client.BackendDetection.ImportDiscoveryRules(1, "web", &BackendDiscoveryRules{})
+		if (err != nil) != test.wantErr {
+			t.Errorf("answer %q: got error %v, want error %t", test.answer, err, test.wantErr)
+		}
+	}
+}
@@ -0,0 +1,89 @@
+/*
+MIT License
+
+Copyright (c) 2026 Cisco Systems, Inc. and its affiliates
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+
+*/
+
+package appdrest
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/cisco-open/appd-client-go/internal/apptest"
+)
+
+func TestBackendRequests(t *testing.T) {
+	tests := []struct {
+		name string
+		call func(c *Client) error
+		path string
+		body string
+	}{
+		{"unresolve", func(c *Client) error { return c.Backend.UnresolveBackendToTier(42) },
+			"/controller/restui/backendUiService/unresolveBackends", `[42]`},
+		{"rename", func(c *Client) error { return c.Backend.RenameBackend(42, "orders-db") },
+			"/controller/restui/backendUiService/renameBackend", `{"id":42,"name":"orders-db"}`},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			requests := 0
+			client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests++
+				if r.Method != "POST" || r.URL.Path != test.path {
+					t.Errorf("got %s %s, want POST %s", r.Method, r.URL.Path, test.path)
+				}
+				if r.Header.Get("X-CSRF-TOKEN") != apptest.CSRFToken {
+					t.Errorf("request without CSRF token")
+				}
+				body, _ := io.ReadAll(r.Body)
+				var got, want interface{}
+				json.Unmarshal(body, &got)
+				json.Unmarshal([]byte(test.body), &want)
+				if !reflect.DeepEqual(got, want) {
+					t.Errorf("body %s, want %s", body, test.body)
+				}
+			}))
+			if err := test.call(client); err != nil {
+				t.Fatal(err)
+			}
+			if requests != 1 {
+				t.Errorf("%d requests, want 1", requests)
+			}
+		})
+	}
+}
+
+func TestDeleteMatchingBackendsEmptyFilter(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s %s", r.Method, r.URL)
+	}))
+	for _, dryRun := range []bool{false, true} {
+		if _, err := client.Backend.DeleteMatching("1", BackendFilter{}, dryRun); !errors.Is(err, ErrEmptyFilter) {
+			t.Errorf("dryRun=%t: got %v, want ErrEmptyFilter", dryRun, err)
+		}
+	}
+}
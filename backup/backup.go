@@ -0,0 +1,593 @@
+/*
+MIT License
+
+Copyright (c) 2026 Cisco Systems, Inc. and its affiliates
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+
+*/
+
+// Package backup writes the configuration of a whole controller to a directory tree
+// with one file per object and restores it selectively by object type or name.
+//
+// Layout of a backup directory:
+//
+//	manifest.json
+//	controller/dashboards/<dashboard>.json
+//	controller/time-ranges/<time range>.json
+//	controller/analytics-searches/<search>.json
+//	applications/<application>/application.xml (names mapping to the same file name get "-<id>" appended)
+//	applications/<application>/transaction-detection.xml
+//	applications/<application>/backend-detection.xml
+//	applications/<application>/backend-detection/<tier>.xml
+//	applications/<application>/health-rules/<health rule>.json
+//	applications/<application>/actions/<action>.json
+//	applications/<application>/policies/<policy>.json
+package backup
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	appdrest "github.com/cisco-open/appd-client-go"
+)
+
+// FormatVersion is the version of the directory layout written by Backup
+const FormatVersion = 1
+
+// ManifestFile is the name of the manifest in the backup directory
+const ManifestFile = "manifest.json"
+
+// ObjectType is the type of a backed up object
+type ObjectType string
+
+// Consts for the object types, restore processes them in this order
+const (
+	ApplicationConfig    ObjectType = "application-config"
+	HealthRule           ObjectType = "health-rule"
+	Action               ObjectType = "action"
+	Policy               ObjectType = "policy"
+	TransactionDetection ObjectType = "transaction-detection"
+	BackendDetection     ObjectType = "backend-detection"
+	Dashboard            ObjectType = "dashboard"
+	TimeRange            ObjectType = "time-range"
+	AnalyticsSearch      ObjectType = "analytics-search"
+)
+
+// AllTypes lists every object type in restore order
+var AllTypes = []ObjectType{ApplicationConfig, HealthRule, Action, Policy, TransactionDetection, BackendDetection, Dashboard, TimeRange, AnalyticsSearch}
+
+// Entry describes one file of the backup
+type Entry struct {
+	Type        ObjectType `json:"type"`
+	Application string     `json:"application,omitempty"`
+	Name        string     `json:"name"`
+	File        string     `json:"file"`
+	SHA256      string     `json:"sha256"`
+}
+
+// Manifest lists all objects of a backup
+type Manifest struct {
+	FormatVersion int       `json:"formatVersion"`
+	Controller    string    `json:"controller"`
+	Account       string    `json:"account"`
+	CreatedAt     time.Time `json:"createdAt"`
+	Objects       []Entry   `json:"objects"`
+	Failures      []string  `json:"failures,omitempty"`
+}
+
+// Options select what is backed up
+type Options struct {
+	Applications []string     // application names, empty for all
+	Types        []ObjectType // object types, empty for all
+}
+
+func (o Options) wantsType(t ObjectType) bool {
+	return len(o.Types) == 0 || containsType(o.Types, t)
+}
+
+func (o Options) wantsApplication(name string) bool {
+	if len(o.Applications) == 0 {
+		return true
+	}
+	for _, app := range o.Applications {
+		if app == name {
+			return true
+		}
+	}
+	return false
+}
+
+func containsType(types []ObjectType, t ObjectType) bool {
+	for _, candidate := range types {
+		if candidate == t {
+			return true
+		}
+	}
+	return false
+}
+
+// ReadManifest reads the manifest of a backup directory
+func ReadManifest(dir string) (*Manifest, error) {
+	body, err := os.ReadFile(filepath.Join(dir, ManifestFile))
+	if err != nil {
+		return nil, err
+	}
+	var manifest Manifest
+	err = json.Unmarshal(body, &manifest)
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %v", ManifestFile, err)
+	}
+	return &manifest, nil
+}
+
+// writer writes objects below dir and records them in the manifest
+type writer struct {
+	dir      string
+	manifest *Manifest
+	used     map[string]bool    // written files in lower case, they must not collide on case-insensitive file systems
+	listed   map[scope]bool     // scopes whose objects were listed completely
+	failed   map[objectKey]bool // objects that were listed but could not be written
+}
+
+// scope is the set of objects of one type within one application, or on the controller for an empty application
+type scope struct {
+	Type        ObjectType
+	Application string
+}
+
+type objectKey struct {
+	Type        ObjectType
+	Application string
+	Name        string
+}
+
+// Backup writes the configuration of the controller to dir.
+// Objects that cannot be read are listed in Manifest.Failures and reported in the returned error,
+// everything else is still written. A file of a previous backup in dir is only removed when its
+// object was listed successfully and no longer exists, or its application was deleted.
+// Files of objects that failed or were not selected by opts are kept and stay in the manifest.
+// Other files are never touched.
+func Backup(client *appdrest.Client, dir string, opts Options) (*Manifest, error) {
+	previous, err := ReadManifest(dir)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, err
+	}
+	if previous == nil {
+		entries, err := os.ReadDir(dir)
+		if err == nil && len(entries) > 0 {
+			return nil, fmt.Errorf("backup directory %s is not empty and has no %s", dir, ManifestFile)
+		}
+	}
+
+	w := &writer{
+		dir: dir,
+		manifest: &Manifest{
+			FormatVersion: FormatVersion,
+			Controller:    client.Controller.Host,
+			Account:       client.Controller.Account,
+			CreatedAt:     time.Now().UTC(),
+		},
+		used:   make(map[string]bool),
+		listed: make(map[scope]bool),
+		failed: make(map[objectKey]bool),
+	}
+
+	apps, err := client.Application.GetApplications()
+	if err != nil {
+		return nil, fmt.Errorf("listing applications: %v", err)
+	}
+	sort.Slice(apps, byNameAndID(func(i int) (string, int) { return apps[i].Name, apps[i].ID }))
+	present := make(map[string]bool, len(apps))
+	dirs := uniqueNames(len(apps), func(i int) (string, int) { return apps[i].Name, apps[i].ID })
+	for i, app := range apps {
+		present[app.Name] = true
+		if opts.wantsApplication(app.Name) {
+			w.application(client, app, path.Join("applications", dirs[i]), opts)
+		}
+	}
+	w.controller(client, opts)
+
+	var stale []string
+	if previous != nil {
+		for _, entry := range previous.Objects {
+			if w.used[strings.ToLower(entry.File)] {
+				continue
+			}
+			if w.gone(entry, opts, present) {
+				stale = append(stale, entry.File)
+				continue
+			}
+			w.used[strings.ToLower(entry.File)] = true
+			w.manifest.Objects = append(w.manifest.Objects, entry)
+		}
+	}
+
+	sort.Slice(w.manifest.Objects, func(i, j int) bool { return w.manifest.Objects[i].File < w.manifest.Objects[j].File })
+	body, err := stableJSON(w.manifest)
+	if err != nil {
+		return nil, err
+	}
+	err = os.WriteFile(filepath.Join(dir, ManifestFile), body, 0644)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, file := range stale {
+		os.Remove(filepath.Join(dir, filepath.FromSlash(file)))
+	}
+
+	if len(w.manifest.Failures) > 0 {
+		return w.manifest, fmt.Errorf("backup incomplete, %d objects failed: %s", len(w.manifest.Failures), strings.Join(w.manifest.Failures, "; "))
+	}
+	return w.manifest, nil
+}
+
+// backendDetection writes the backend detection of the application scope for an empty tier, else of the tier
+func (w *writer) backendDetection(client *appdrest.Client, app *appdrest.Application, tier string, file string) {
+	name := app.Name
+	if tier != "" {
+		name = tier
+	}
+	detection, err := client.BackendDetection.ExportBackendDetection(app.ID, tier)
+	if err == nil {
+		var body []byte
+		body, err = stableXML(detection)
+		if err == nil {
+			err = w.write(BackendDetection, app.Name, name, file, body)
+		}
+	}
+	if err != nil {
+		w.failObject(BackendDetection, app.Name, name, err)
+	}
+}
+
+// gone reports whether the object of a previous entry is confirmed to no longer exist
+func (w *writer) gone(entry Entry, opts Options, present map[string]bool) bool {
+	if !opts.wantsType(entry.Type) {
+		return false
+	}
+	if entry.Application != "" {
+		if !opts.wantsApplication(entry.Application) {
+			return false
+		}
+		if !present[entry.Application] {
+			return true
+		}
+	}
+	return w.listed[scope{entry.Type, entry.Application}] && !w.failed[objectKey{entry.Type, entry.Application, entry.Name}]
+}
+
+// list records that all objects of a type in an application were listed
+func (w *writer) list(t ObjectType, application string) {
+	w.listed[scope{t, application}] = true
+}
+
+func (w *writer) fail(format string, args ...interface{}) {
+	w.manifest.Failures = append(w.manifest.Failures, fmt.Sprintf(format, args...))
+}
+
+// failObject records a listed object that could not be written, its previous file is kept
+func (w *writer) failObject(t ObjectType, application string, name string, err error) {
+	w.failed[objectKey{t, application, name}] = true
+	if application == "" {
+		w.fail("%s %s: %v", t, name, err)
+	} else if name == application {
+		w.fail("%s %s: %v", t, application, err)
+	} else {
+		w.fail("%s %s/%s: %v", t, application, name, err)
+	}
+}
+
+func (w *writer) application(client *appdrest.Client, app *appdrest.Application, base string, opts Options) {
+
+	if opts.wantsType(ApplicationConfig) {
+		w.list(ApplicationConfig, app.Name)
+		config, err := client.Application.GetApplicationConfig(app.ID)
+		if err == nil {
+			var body []byte
+			body, err = config.Marshal()
+			if err == nil {
+				err = w.write(ApplicationConfig, app.Name, app.Name, path.Join(base, "application.xml"), body)
+			}
+		}
+		if err != nil {
+			w.failObject(ApplicationConfig, app.Name, app.Name, err)
+		}
+	}
+
+	if opts.wantsType(TransactionDetection) {
+		w.list(TransactionDetection, app.Name)
+		rules, err := client.TxDetectionRule.ExportTransactionDetectionRules(strconv.Itoa(app.ID))
+		if err == nil {
+			var body []byte
+			body, err = stableXML(rules)
+			if err == nil {
+				err = w.write(TransactionDetection, app.Name, app.Name, path.Join(base, "transaction-detection.xml"), body)
+			}
+		}
+		if err != nil {
+			w.failObject(TransactionDetection, app.Name, app.Name, err)
+		}
+	}
+
+	if opts.wantsType(BackendDetection) {
+		w.backendDetection(client, app, "", path.Join(base, "backend-detection.xml"))
+		tiers, err := client.Tier.GetTiers(app.ID)
+		if err != nil {
+			w.fail("%s %s: %v", BackendDetection, app.Name, err)
+		} else {
+			w.list(BackendDetection, app.Name)
+		}
+		files := uniqueNames(len(tiers), func(i int) (string, int) { return tiers[i].Name, tiers[i].ID })
+		for i, tier := range tiers {
+			w.backendDetection(client, app, tier.Name, path.Join(base, "backend-detection", files[i]+".xml"))
+		}
+	}
+
+	if opts.wantsType(HealthRule) {
+		rules, err := client.HealthRule.GetHealthRules(app.ID)
+		if err != nil {
+			w.fail("%s %s: %v", HealthRule, app.Name, err)
+		} else {
+			w.list(HealthRule, app.Name)
+		}
+		sort.Slice(rules, byNameAndID(func(i int) (string, int) { return rules[i].Name, rules[i].ID }))
+		for _, rule := range rules {
+			detail, err := client.HealthRule.GetRawHealthRule(app.ID, rule.ID)
+			if err == nil {
+				err = w.writeJSON(HealthRule, app.Name, rule.Name, path.Join(base, "health-rules"), detail)
+			}
+			if err != nil {
+				w.failObject(HealthRule, app.Name, rule.Name, err)
+			}
+		}
+	}
+
+	if opts.wantsType(Action) {
+		actions, err := client.Action.GetActions(app.ID)
+		if err != nil {
+			w.fail("%s %s: %v", Action, app.Name, err)
+		} else {
+			w.list(Action, app.Name)
+		}
+		sort.Slice(actions, byNameAndID(func(i int) (string, int) { return actions[i].Name, actions[i].ID }))
+		for _, action := range actions {
+			detail, err := client.Action.GetActionDetails(app.ID, action.ID)
+			if err == nil {
+				err = w.writeJSON(Action, app.Name, action.Name, path.Join(base, "actions"), detail)
+			}
+			if err != nil {
+				w.failObject(Action, app.Name, action.Name, err)
+			}
+		}
+	}
+
+	if opts.wantsType(Policy) {
+		policies, err := client.Policy.GetPolicies(app.ID)
+		if err != nil {
+			w.fail("%s %s: %v", Policy, app.Name, err)
+		} else {
+			w.list(Policy, app.Name)
+		}
+		sort.Slice(policies, byNameAndID(func(i int) (string, int) { return policies[i].Name, policies[i].ID }))
+		for _, policy := range policies {
+			detail, err := client.Policy.GetPolicyDetails(app.ID, policy.ID)
+			if err == nil {
+				err = w.writeJSON(Policy, app.Name, policy.Name, path.Join(base, "policies"), detail)
+			}
+			if err != nil {
+				w.failObject(Policy, app.Name, policy.Name, err)
+			}
+		}
+	}
+}
+
+func (w *writer) controller(client *appdrest.Client, opts Options) {
+	if opts.wantsType(Dashboard) {
+		dashboards, err := client.Dashboard.GetDashboards()
+		if err != nil {
+			w.fail("%s: %v", Dashboard, err)
+		} else {
+			w.list(Dashboard, "")
+		}
+		sort.Slice(dashboards, byNameAndID(func(i int) (string, int) { return dashboards[i].Name, dashboards[i].ID }))
+		for _, dashboard := range dashboards {
+			export, err := client.Dashboard.GetDashboardExport(dashboard.ID)
+			if err == nil {
+				err = w.writeJSON(Dashboard, "", dashboard.Name, "controller/dashboards", export)
+			}
+			if err != nil {
+				w.failObject(Dashboard, "", dashboard.Name, err)
+			}
+		}
+	}
+
+	if opts.wantsType(TimeRange) {
+		timeRanges, err := client.TimeRange.GetTimeRanges()
+		if err != nil {
+			w.fail("%s: %v", TimeRange, err)
+		} else {
+			w.list(TimeRange, "")
+		}
+		sort.Slice(timeRanges, byNameAndID(func(i int) (string, int) { return timeRanges[i].Name, timeRanges[i].ID }))
+		for _, timeRange := range timeRanges {
+			if timeRange.BuiltIn {
+				continue
+			}
+			err := w.writeJSON(TimeRange, "", timeRange.Name, "controller/time-ranges", timeRange)
+			if err != nil {
+				w.failObject(TimeRange, "", timeRange.Name, err)
+			}
+		}
+	}
+
+	if opts.wantsType(AnalyticsSearch) {
+		searches, err := client.Analytics.GetAnalyticsSearches()
+		if err != nil {
+			w.fail("%s: %v", AnalyticsSearch, err)
+		} else {
+			w.list(AnalyticsSearch, "")
+		}
+		sort.Slice(searches, byNameAndID(func(i int) (string, int) { return searches[i].Name, searches[i].ID }))
+		for _, search := range searches {
+			err := w.writeJSON(AnalyticsSearch, "", search.Name, "controller/analytics-searches", search)
+			if err != nil {
+				w.failObject(AnalyticsSearch, "", search.Name, err)
+			}
+		}
+	}
+}
+
+// writeJSON writes v as stable JSON to a file named after the object in dir
+func (w *writer) writeJSON(t ObjectType, application string, name string, dir string, v interface{}) error {
+	body, err := stableJSON(v)
+	if err != nil {
+		return err
+	}
+	file := path.Join(dir, safeName(name)+".json")
+	for i := 2; w.used[strings.ToLower(file)]; i++ {
+		file = path.Join(dir, fmt.Sprintf("%s~%d.json", safeName(name), i))
+	}
+	return w.write(t, application, name, file, body)
+}
+
+func (w *writer) write(t ObjectType, application string, name string, file string, body []byte) error {
+	target := filepath.Join(w.dir, filepath.FromSlash(file))
+	err := os.MkdirAll(filepath.Dir(target), 0755)
+	if err != nil {
+		return err
+	}
+	err = os.WriteFile(target, body, 0644)
+	if err != nil {
+		return err
+	}
+
+	sum := sha256.Sum256(body)
+	w.used[strings.ToLower(file)] = true
+	w.manifest.Objects = append(w.manifest.Objects, Entry{
+		Type:        t,
+		Application: application,
+		Name:        name,
+		File:        file,
+		SHA256:      hex.EncodeToString(sum[:]),
+	})
+	return nil
+}
+
+// stableJSON formats v with sorted keys and two space indentation
+func stableJSON(v interface{}) ([]byte, error) {
+	body, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var generic interface{}
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	err = decoder.Decode(&generic)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	err = enc.Encode(generic)
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// stableXML formats v with four space indentation
+func stableXML(v interface{}) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteString(xml.Header)
+	enc := xml.NewEncoder(&buf)
+	enc.Indent("", "    ")
+	err := enc.Encode(v)
+	if err != nil {
+		return nil, err
+	}
+	buf.WriteString("\n")
+	return buf.Bytes(), nil
+}
+
+// byNameAndID orders entities by name and ID for sort.Slice, so file names and their ~N suffixes
+// do not depend on the order the controller lists objects in
+func byNameAndID(entity func(i int) (string, int)) func(i, j int) bool {
+	return func(i, j int) bool {
+		nameI, idI := entity(i)
+		nameJ, idJ := entity(j)
+		if nameI != nameJ {
+			return nameI < nameJ
+		}
+		return idI < idJ
+	}
+}
+
+// uniqueNames returns the safeName of n entities identified by name and ID.
+// Entities whose names map to the same safeName get their ID appended, so they never share a file.
+func uniqueNames(n int, entity func(i int) (string, int)) []string {
+	count := make(map[string]int, n)
+	for i := 0; i < n; i++ {
+		name, _ := entity(i)
+		count[strings.ToLower(safeName(name))]++
+	}
+	names := make([]string, n)
+	for i := range names {
+		name, id := entity(i)
+		names[i] = safeName(name)
+		if count[strings.ToLower(names[i])] > 1 {
+			names[i] = fmt.Sprintf("%s-%d", names[i], id)
+		}
+	}
+	return names
+}
+
+// safeName turns an object name into a portable file name
+func safeName(name string) string {
+	var b strings.Builder
+	for _, r := range name {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '-', r == '_', r == '.':
+			b.WriteRune(r)
+		default:
+			b.WriteRune('_')
+		}
+	}
+	safe := strings.Trim(b.String(), ".")
+	if safe == "" {
+		return "_"
+	}
+	return safe
+}
@@ -0,0 +1,228 @@
+/*
+MIT License
+
+Copyright (c) 2026 Cisco Systems, Inc. and its affiliates
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+
+*/
+
+package backup
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+
+	appdrest "github.com/cisco-open/appd-client-go"
+	"github.com/cisco-open/appd-client-go/internal/apptest"
+)
+
+// fakeController serves the health rules of application 1
+type fakeController struct {
+	rules      map[int]string
+	listFails  bool
+	detailFail map[int]bool
+	listCalls  int
+}
+
+func (f *fakeController) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	const prefix = "/controller/alerting/rest/v1/applications/1/health-rules"
+	switch {
+	case r.URL.Path == "/controller/rest/applications":
+		fmt.Fprint(w, `[{"id":1,"name":"shop"}]`)
+	case r.URL.Path == "/controller/alerting/rest/v1/applications/1/actions":
+		fmt.Fprint(w, `[]`)
+	case r.URL.Path == prefix:
+		f.listCalls++
+		if f.listFails {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		var rules []map[string]interface{}
+		for id, name := range f.rules {
+			rules = append(rules, map[string]interface{}{"id": id, "name": name})
+		}
+		json.NewEncoder(w).Encode(rules)
+	case strings.HasPrefix(r.URL.Path, prefix+"/"):
+		id, _ := strconv.Atoi(strings.TrimPrefix(r.URL.Path, prefix+"/"))
+		if f.detailFail[id] {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{"id": id, "name": f.rules[id]})
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func newTestClient(t *testing.T, handler http.Handler) *appdrest.Client {
+	t.Helper()
+	host, port := apptest.Server(t, handler)
+	client, err := appdrest.NewClient("http", host, port, "user", "secret", "customer1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return client
+}
+
+func manifestFiles(t *testing.T, dir string) map[string]bool {
+	t.Helper()
+	manifest, err := ReadManifest(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	files := make(map[string]bool)
+	for _, entry := range manifest.Objects {
+		files[entry.File] = true
+		if _, err := os.Stat(filepath.Join(dir, filepath.FromSlash(entry.File))); err != nil {
+			t.Errorf("manifest lists %s: %v", entry.File, err)
+		}
+	}
+	return files
+}
+
+func TestBackupPrune(t *testing.T) {
+	const (
+		ruleA = "applications/shop/health-rules/cpu.json"
+		ruleB = "applications/shop/health-rules/memory.json"
+	)
+	controller := &fakeController{rules: map[int]string{1: "cpu", 2: "memory"}, detailFail: map[int]bool{}}
+	client := newTestClient(t, controller)
+	dir := t.TempDir()
+	healthRules := Options{Types: []ObjectType{HealthRule}}
+
+	steps := []struct {
+		name    string
+		prepare func()
+		opts    Options
+		want    []string
+		wantErr bool
+	}{
+		{"initial", func() {}, healthRules, []string{ruleA, ruleB}, false},
+		{"detail fails", func() { controller.detailFail[1] = true }, healthRules, []string{ruleA, ruleB}, true},
+		{"list fails", func() { controller.detailFail[1] = false; controller.listFails = true; delete(controller.rules, 2) }, healthRules, []string{ruleA, ruleB}, true},
+		{"type filtered", func() { controller.listFails = false }, Options{Types: []ObjectType{Action}}, []string{ruleA, ruleB}, false},
+		{"application filtered", func() {}, Options{Types: []ObjectType{HealthRule}, Applications: []string{"other"}}, []string{ruleA, ruleB}, false},
+		{"deleted", func() {}, healthRules, []string{ruleA}, false},
+	}
+	for _, step := range steps {
+		step.prepare()
+		_, err := Backup(client, dir, step.opts)
+		if (err != nil) != step.wantErr {
+			t.Fatalf("%s: error %v, want error %t", step.name, err, step.wantErr)
+		}
+		files := manifestFiles(t, dir)
+		if len(files) != len(step.want) {
+			t.Errorf("%s: manifest lists %v, want %v", step.name, files, step.want)
+		}
+		for _, file := range step.want {
+			if !files[file] {
+				t.Errorf("%s: %s missing from manifest", step.name, file)
+			}
+		}
+	}
+	if _, err := os.Stat(filepath.Join(dir, ruleB)); !os.IsNotExist(err) {
+		t.Errorf("%s not removed: %v", ruleB, err)
+	}
+}
+
+func TestUniqueNames(t *testing.T) {
+	apps := []struct {
+		name string
+		id   int
+	}{{"Shop/EU", 7}, {"Shop:EU", 8}, {"shop_eu", 9}, {"Billing", 10}}
+	got := uniqueNames(len(apps), func(i int) (string, int) { return apps[i].name, apps[i].id })
+	want := []string{"Shop_EU-7", "Shop_EU-8", "shop_eu-9", "Billing"}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("uniqueNames()[%d] = %q, want %q", i, got[i], want[i])
+		}
+	}
+}
+
+func TestBackupDeterministicLayout(t *testing.T) {
+	// the fake controller lists rules in random map order
+	controller := &fakeController{rules: map[int]string{1: "cpu", 2: "Cpu", 3: "cpu", 4: "memory"}, detailFail: map[int]bool{}}
+	client := newTestClient(t, controller)
+	healthRules := Options{Types: []ObjectType{HealthRule}}
+
+	var layouts []map[string]string
+	for i := 0; i < 5; i++ {
+		dir := t.TempDir()
+		if _, err := Backup(client, dir, healthRules); err != nil {
+			t.Fatal(err)
+		}
+		manifest, err := ReadManifest(dir)
+		if err != nil {
+			t.Fatal(err)
+		}
+		layout := make(map[string]string)
+		for _, entry := range manifest.Objects {
+			layout[entry.File] = entry.SHA256
+		}
+		layouts = append(layouts, layout)
+	}
+
+	want := []string{
+		"applications/shop/health-rules/Cpu.json",
+		"applications/shop/health-rules/cpu~2.json", // same file as Cpu.json on case-insensitive file systems
+		"applications/shop/health-rules/cpu~3.json",
+		"applications/shop/health-rules/memory.json",
+	}
+	for _, file := range want {
+		if _, ok := layouts[0][file]; !ok {
+			t.Errorf("%s missing from %v", file, layouts[0])
+		}
+	}
+	for _, layout := range layouts[1:] {
+		if !reflect.DeepEqual(layout, layouts[0]) {
+			t.Fatalf("layout changed between backups:\n%v\n%v", layouts[0], layout)
+		}
+	}
+}
+
+func TestRestoreListsOncePerApplication(t *testing.T) {
+	controller := &fakeController{rules: map[int]string{1: "cpu", 2: "memory", 3: "disk"}, detailFail: map[int]bool{}}
+	client := newTestClient(t, controller)
+	dir := t.TempDir()
+	if _, err := Backup(client, dir, Options{Types: []ObjectType{HealthRule}}); err != nil {
+		t.Fatal(err)
+	}
+
+	controller.listCalls = 0
+	report, err := Restore(client, dir, RestoreOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, result := range report.Results {
+		if result.Action != Skipped {
+			t.Errorf("%s: %s %s", result.Entry.Name, result.Action, result.Reason)
+		}
+	}
+	if len(report.Results) != 3 || controller.listCalls != 1 {
+		t.Errorf("restoring %d rules listed the health rules %d times, want once", len(report.Results), controller.listCalls)
+	}
+}
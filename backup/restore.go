@@ -0,0 +1,529 @@
+/*
+MIT License
+
+Copyright (c) 2026 Cisco Systems, Inc. and its affiliates
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+
+*/
+
+package backup
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+
+	appdrest "github.com/cisco-open/appd-client-go"
+)
+
+// RestoreOptions select what is restored and how existing objects are handled
+type RestoreOptions struct {
+	Types        []ObjectType // object types, empty for all
+	Names        []string     // object name patterns as in path.Match, empty for all
+	Applications []string     // application names, empty for all
+	Overwrite    bool         // replace objects that already exist on the controller
+	DryRun       bool         // only report what would be done
+}
+
+func (o RestoreOptions) matches(entry Entry) bool {
+	if len(o.Types) > 0 && !containsType(o.Types, entry.Type) {
+		return false
+	}
+	if entry.Application != "" && !(Options{Applications: o.Applications}).wantsApplication(entry.Application) {
+		return false
+	}
+	if len(o.Names) == 0 {
+		return true
+	}
+	for _, pattern := range o.Names {
+		if ok, _ := path.Match(pattern, entry.Name); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// Consts for the outcome of restoring one object
+const (
+	Created  = "created"
+	Updated  = "updated"
+	Imported = "imported"
+	Skipped  = "skipped"
+	Failed   = "failed"
+)
+
+// RestoreResult is the outcome for one object of the backup
+type RestoreResult struct {
+	Entry  Entry  `json:"entry"`
+	Action string `json:"action"`
+	DryRun bool   `json:"dryRun,omitempty"`
+	Reason string `json:"reason,omitempty"`
+}
+
+// RestoreReport lists the outcome for every selected object
+type RestoreReport struct {
+	Results []RestoreResult `json:"results"`
+}
+
+// Failed returns the objects that could not be restored
+func (r *RestoreReport) Failed() []RestoreResult {
+	var failed []RestoreResult
+	for _, result := range r.Results {
+		if result.Action == Failed {
+			failed = append(failed, result)
+		}
+	}
+	return failed
+}
+
+// restorer caches controller state looked up while restoring
+type restorer struct {
+	client *appdrest.Client
+	dir    string
+	opts   RestoreOptions
+	apps   map[string]int
+	lists  map[listKey]map[string]existingObject // objects on the target by name, listed once per type and application
+}
+
+// listKey identifies the objects of one type within one application, or on the controller for appID 0
+type listKey struct {
+	Type  ObjectType
+	AppID int
+}
+
+// existingObject is what restore needs to know about an object already on the target
+type existingObject struct {
+	ID      int
+	Version int
+	BuiltIn bool
+}
+
+// existing returns the objects of a type by name, list is only called the first time per type and application
+func (r *restorer) existing(t ObjectType, appID int, list func() (map[string]existingObject, error)) (map[string]existingObject, error) {
+	key := listKey{Type: t, AppID: appID}
+	if objects, ok := r.lists[key]; ok {
+		return objects, nil
+	}
+	objects, err := list()
+	if err != nil {
+		return nil, err
+	}
+	if r.lists == nil {
+		r.lists = make(map[listKey]map[string]existingObject)
+	}
+	r.lists[key] = objects
+	return objects, nil
+}
+
+// created forgets the listed objects of a type after one was created, so the next lookup sees it
+func (r *restorer) created(t ObjectType, appID int) {
+	delete(r.lists, listKey{Type: t, AppID: appID})
+}
+
+// Restore re-imports the objects of the backup in dir selected by opts.
+// Objects are processed in the order of AllTypes so that applications exist before
+// their health rules and actions exist before the policies referring to them.
+// Failing objects are reported in the RestoreReport, the returned error is only set
+// when the backup itself cannot be read.
+func Restore(client *appdrest.Client, dir string, opts RestoreOptions) (*RestoreReport, error) {
+	manifest, err := ReadManifest(dir)
+	if err != nil {
+		return nil, err
+	}
+	if manifest.FormatVersion > FormatVersion {
+		return nil, fmt.Errorf("backup format version %d is newer than supported version %d", manifest.FormatVersion, FormatVersion)
+	}
+
+	r := &restorer{client: client, dir: dir, opts: opts}
+	report := &RestoreReport{}
+	for _, t := range AllTypes {
+		for _, entry := range manifest.Objects {
+			if entry.Type != t || !opts.matches(entry) {
+				continue
+			}
+			result := RestoreResult{Entry: entry, DryRun: opts.DryRun}
+			action, err := r.restore(entry)
+			if err != nil {
+				result.Action = Failed
+				result.Reason = err.Error()
+			} else {
+				result.Action = action
+				if action == Skipped {
+					result.Reason = "already exists"
+				}
+			}
+			report.Results = append(report.Results, result)
+		}
+	}
+	return report, nil
+}
+
+func (r *restorer) read(entry Entry) ([]byte, error) {
+	body, err := os.ReadFile(filepath.Join(r.dir, filepath.FromSlash(entry.File)))
+	if err != nil {
+		return nil, err
+	}
+	sum := sha256.Sum256(body)
+	if hex.EncodeToString(sum[:]) != entry.SHA256 {
+		return nil, fmt.Errorf("checksum mismatch for %s", entry.File)
+	}
+	return body, nil
+}
+
+// applicationID returns the ID of the named application on the target controller, 0 if it does not exist
+func (r *restorer) applicationID(name string) (int, error) {
+	if r.apps == nil {
+		apps, err := r.client.Application.GetApplications()
+		if err != nil {
+			return 0, err
+		}
+		r.apps = make(map[string]int)
+		for _, app := range apps {
+			r.apps[app.Name] = app.ID
+		}
+	}
+	return r.apps[name], nil
+}
+
+func (r *restorer) restore(entry Entry) (string, error) {
+	body, err := r.read(entry)
+	if err != nil {
+		return "", err
+	}
+
+	switch entry.Type {
+	case ApplicationConfig:
+		return r.applicationConfig(entry, body)
+	case Dashboard:
+		return r.dashboard(body)
+	case TimeRange:
+		return r.timeRange(body)
+	case AnalyticsSearch:
+		return r.analyticsSearch(body)
+	}
+
+	appID, err := r.applicationID(entry.Application)
+	if err != nil {
+		return "", err
+	}
+	if appID == 0 {
+		if r.opts.DryRun && r.willCreateApplication(entry.Application) {
+			return Created, nil
+		}
+		return "", fmt.Errorf("application %s does not exist", entry.Application)
+	}
+
+	switch entry.Type {
+	case HealthRule:
+		return r.healthRule(appID, body)
+	case Action:
+		return r.action(appID, body)
+	case Policy:
+		return r.policy(appID, body)
+	case TransactionDetection:
+		return r.transactionDetection(appID, body)
+	case BackendDetection:
+		return r.backendDetection(appID, body)
+	}
+	return "", fmt.Errorf("unknown object type %s", entry.Type)
+}
+
+// willCreateApplication reports if the application config of a missing application is restored as well
+func (r *restorer) willCreateApplication(name string) bool {
+	return r.opts.matches(Entry{Type: ApplicationConfig, Application: name, Name: name})
+}
+
+func (r *restorer) applicationConfig(entry Entry, body []byte) (string, error) {
+	appID, err := r.applicationID(entry.Application)
+	if err != nil {
+		return "", err
+	}
+	action := Imported
+	if appID == 0 {
+		action = Created
+		if r.opts.DryRun {
+			return action, nil
+		}
+		app, err := r.client.Application.CreateApplication(entry.Application, "")
+		if err != nil {
+			return "", err
+		}
+		appID = app.ID
+		r.apps[app.Name] = app.ID
+	}
+	if r.opts.DryRun {
+		return action, nil
+	}
+	return action, r.client.Application.ImportApplicationConfig(appID, body, r.opts.Overwrite)
+}
+
+func (r *restorer) healthRule(appID int, body []byte) (string, error) {
+	// the raw form keeps fields HealthRuleDetail does not model
+	var rule appdrest.RawHealthRule
+	err := json.Unmarshal(body, &rule)
+	if err != nil {
+		return "", err
+	}
+	if rule.Name() == "" {
+		return "", errors.New("health rule without name")
+	}
+	existing, err := r.existing(HealthRule, appID, func() (map[string]existingObject, error) {
+		rules, err := r.client.HealthRule.GetHealthRules(appID)
+		objects := make(map[string]existingObject, len(rules))
+		for _, rule := range rules {
+			objects[rule.Name] = existingObject{ID: rule.ID}
+		}
+		return objects, err
+	})
+	if err != nil {
+		return "", err
+	}
+	if candidate, ok := existing[rule.Name()]; ok {
+		if !r.opts.Overwrite {
+			return Skipped, nil
+		}
+		if r.opts.DryRun {
+			return Updated, nil
+		}
+		rule["id"] = candidate.ID
+		return Updated, r.client.HealthRule.UpdateRawHealthRule(appID, candidate.ID, rule)
+	}
+	if r.opts.DryRun {
+		return Created, nil
+	}
+	delete(rule, "id")
+	r.created(HealthRule, appID)
+	return Created, r.client.HealthRule.CreateRawHealthRule(appID, rule)
+}
+
+func (r *restorer) action(appID int, body []byte) (string, error) {
+	var action appdrest.ActionDetail
+	err := json.Unmarshal(body, &action)
+	if err != nil {
+		return "", err
+	}
+	existing, err := r.existing(Action, appID, func() (map[string]existingObject, error) {
+		actions, err := r.client.Action.GetActions(appID)
+		objects := make(map[string]existingObject, len(actions))
+		for _, action := range actions {
+			objects[action.Name] = existingObject{ID: action.ID}
+		}
+		return objects, err
+	})
+	if err != nil {
+		return "", err
+	}
+	delete(action, "id")
+	if candidate, ok := existing[action.Name()]; ok {
+		if !r.opts.Overwrite {
+			return Skipped, nil
+		}
+		if r.opts.DryRun {
+			return Updated, nil
+		}
+		action["id"] = candidate.ID
+		return Updated, r.client.Action.UpdateAction(appID, candidate.ID, action)
+	}
+	if r.opts.DryRun {
+		return Created, nil
+	}
+	r.created(Action, appID)
+	return Created, r.client.Action.CreateAction(appID, action)
+}
+
+func (r *restorer) policy(appID int, body []byte) (string, error) {
+	var policy appdrest.PolicyDetail
+	err := json.Unmarshal(body, &policy)
+	if err != nil {
+		return "", err
+	}
+	if policy.Name == nil {
+		return "", errors.New("policy without name")
+	}
+	existing, err := r.existing(Policy, appID, func() (map[string]existingObject, error) {
+		policies, err := r.client.Policy.GetPolicies(appID)
+		objects := make(map[string]existingObject, len(policies))
+		for _, policy := range policies {
+			objects[policy.Name] = existingObject{ID: policy.ID}
+		}
+		return objects, err
+	})
+	if err != nil {
+		return "", err
+	}
+	if candidate, ok := existing[*policy.Name]; ok {
+		if !r.opts.Overwrite {
+			return Skipped, nil
+		}
+		if r.opts.DryRun {
+			return Updated, nil
+		}
+		policy.ID = &candidate.ID
+		return Updated, r.client.Policy.UpdatePolicy(appID, candidate.ID, &policy)
+	}
+	if r.opts.DryRun {
+		return Created, nil
+	}
+	policy.ID = nil
+	r.created(Policy, appID)
+	return Created, r.client.Policy.CreatePolicy(appID, &policy)
+}
+
+func (r *restorer) transactionDetection(appID int, body []byte) (string, error) {
+	var rules appdrest.MdsData
+	err := xml.Unmarshal(body, &rules)
+	if err != nil {
+		return "", err
+	}
+	if r.opts.DryRun {
+		return Imported, nil
+	}
+	return Imported, r.client.TxDetectionRule.ImportTransactionDetectionRules(strconv.Itoa(appID), &rules)
+}
+
+func (r *restorer) backendDetection(appID int, body []byte) (string, error) {
+	var detection appdrest.BackendDetection
+	err := xml.Unmarshal(body, &detection)
+	if err != nil {
+		return "", err
+	}
+	if r.opts.DryRun {
+		return Imported, nil
+	}
+	return Imported, r.client.BackendDetection.ImportBackendDetection(appID, &detection)
+}
+
+func (r *restorer) dashboard(body []byte) (string, error) {
+	var dashboard appdrest.DashboardExport
+	err := json.Unmarshal(body, &dashboard)
+	if err != nil {
+		return "", err
+	}
+	if dashboard.Name == nil {
+		return "", errors.New("dashboard without name")
+	}
+	existing, err := r.existing(Dashboard, 0, func() (map[string]existingObject, error) {
+		dashboards, err := r.client.Dashboard.GetDashboards()
+		objects := make(map[string]existingObject, len(dashboards))
+		for _, dashboard := range dashboards {
+			objects[dashboard.Name] = existingObject{ID: dashboard.ID}
+		}
+		return objects, err
+	})
+	if err != nil {
+		return "", err
+	}
+	if candidate, ok := existing[*dashboard.Name]; ok {
+		if !r.opts.Overwrite {
+			return Skipped, nil
+		}
+		if r.opts.DryRun {
+			return Updated, nil
+		}
+		// dashboards can only be replaced as a whole, the replacement gets a new ID
+		r.created(Dashboard, 0)
+		_, err = r.client.Dashboard.ReplaceDashboard(candidate.ID, &dashboard)
+		return Updated, err
+	}
+	if r.opts.DryRun {
+		return Created, nil
+	}
+	r.created(Dashboard, 0)
+	_, err = r.client.Dashboard.ImportDashboard(&dashboard)
+	return Created, err
+}
+
+func (r *restorer) timeRange(body []byte) (string, error) {
+	var timeRange appdrest.TimeRange
+	err := json.Unmarshal(body, &timeRange)
+	if err != nil {
+		return "", err
+	}
+	existing, err := r.existing(TimeRange, 0, func() (map[string]existingObject, error) {
+		timeRanges, err := r.client.TimeRange.GetTimeRanges()
+		objects := make(map[string]existingObject, len(timeRanges))
+		for _, timeRange := range timeRanges {
+			objects[timeRange.Name] = existingObject{ID: timeRange.ID, Version: timeRange.Version, BuiltIn: timeRange.BuiltIn}
+		}
+		return objects, err
+	})
+	if err != nil {
+		return "", err
+	}
+	if candidate, ok := existing[timeRange.Name]; ok {
+		if !r.opts.Overwrite || candidate.BuiltIn {
+			return Skipped, nil
+		}
+		if r.opts.DryRun {
+			return Updated, nil
+		}
+		timeRange.ID = candidate.ID
+		timeRange.Version = candidate.Version
+		r.created(TimeRange, 0) // the update changes the version
+		_, err = r.client.TimeRange.UpdateTimeRange(timeRange)
+		return Updated, err
+	}
+	if r.opts.DryRun {
+		return Created, nil
+	}
+	timeRange.ID = 0
+	timeRange.Version = 0
+	r.created(TimeRange, 0)
+	_, err = r.client.TimeRange.CreateTimeRange(timeRange)
+	return Created, err
+}
+
+func (r *restorer) analyticsSearch(body []byte) (string, error) {
+	var search appdrest.AnalyticsSearch
+	err := json.Unmarshal(body, &search)
+	if err != nil {
+		return "", err
+	}
+	existing, err := r.existing(AnalyticsSearch, 0, func() (map[string]existingObject, error) {
+		searches, err := r.client.Analytics.GetAnalyticsSearches()
+		objects := make(map[string]existingObject, len(searches))
+		for _, search := range searches {
+			objects[search.Name] = existingObject{ID: search.ID}
+		}
+		return objects, err
+	})
+	if err != nil {
+		return "", err
+	}
+	if _, ok := existing[search.Name]; ok {
+		// the controller has no update call for saved searches
+		return Skipped, nil
+	}
+	if r.opts.DryRun {
+		return Created, nil
+	}
+	search.ID = 0
+	search.Version = 0
+	r.created(AnalyticsSearch, 0)
+	_, err = r.client.Analytics.CreateAnalyticsSearch(&search)
+	return Created, err
+}
@@ -0,0 +1,279 @@
+/*
+MIT License
+
+Copyright (c) 2026 Cisco Systems, Inc. and its affiliates
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+
+*/
+
+package appdrest
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+)
+
+// DefaultMaxBaselineMetrics limits the baseline requests of GetMetricDataWithBaseline when the query sets no limit
+const DefaultMaxBaselineMetrics = 50
+
+// ErrTooManyBaselineMetrics is returned when a query matches more metrics than baselines are requested for
+var ErrTooManyBaselineMetrics = errors.New("too many metrics for baseline data")
+
+// Baseline describes one baseline configured for an application
+type Baseline struct {
+	ID                   int    `json:"id"`
+	Version              int    `json:"version"`
+	Name                 string `json:"name"`
+	NameUnique           bool   `json:"nameUnique"`
+	ApplicationID        int    `json:"applicationId"`
+	DefaultBaseline      bool   `json:"defaultBaseline"`
+	Seasonality          string `json:"seasonality"`
+	BaselineTimeRange    string `json:"baselineTimeRange"`
+	NumberOfDays         int    `json:"numberOfDays"`
+	TrendingEnabled      bool   `json:"trendingEnabled"`
+	FixedStartTimeMillis int64  `json:"fixedStartTimeMillis"`
+	FixedEndTimeMillis   int64  `json:"fixedEndTimeMillis"`
+}
+
+// MetricValueBaseline pairs a metric value with the baseline of its minute
+type MetricValueBaseline struct {
+	MetricValue
+	HasBaseline               bool
+	BaselineValue             float64
+	BaselineStandardDeviation float64
+}
+
+// Deviation returns how many baseline standard deviations the value is away from the baseline.
+// It returns NaN when there is no baseline or the standard deviation is zero.
+func (v MetricValueBaseline) Deviation() float64 {
+	if !v.HasBaseline || v.BaselineStandardDeviation == 0 {
+		return math.NaN()
+	}
+	return (float64(v.Value) - v.BaselineValue) / v.BaselineStandardDeviation
+}
+
+// MetricBaselineData contains metric values of a single metric paired with baseline values
+type MetricBaselineData struct {
+	MetricName   string                `json:"metricName"`
+	MetricID     int                   `json:"metricId"`
+	MetricPath   string                `json:"metricPath"`
+	Frequency    string                `json:"frequency"`
+	Baseline     *Baseline             `json:"baseline"`
+	MetricValues []MetricValueBaseline `json:"metricValues"`
+}
+
+// DANGER ZONE
+// following types are used for UNPUBLISHED api call and it may change in the future
+type metricBaselineRequest struct {
+	MetricID           int                         `json:"metricId"`
+	ApplicationID      int                         `json:"applicationId"`
+	BaselineID         int                         `json:"baselineId"`
+	TimeRangeSpecifier metricBaselineTimeRangeSpec `json:"timeRangeSpecifier"`
+}
+
+type metricBaselineTimeRangeSpec struct {
+	Type              string `json:"type"`
+	DurationInMinutes int    `json:"durationInMinutes"`
+	StartTime         int64  `json:"startTime"`
+	EndTime           int64  `json:"endTime"`
+}
+
+type metricBaselineResponse struct {
+	DataTimeslices []struct {
+		StartTime   int64 `json:"startTime"`
+		MetricValue *struct {
+			Value             float64 `json:"value"`
+			StandardDeviation float64 `json:"standardDeviation"`
+		} `json:"metricValue"`
+	} `json:"dataTimeslices"`
+}
+
+// DANGER ZONE END
+
+// GetBaselines obtains all baselines of an application
+// DANGER ZONE
+// this is an UNPUBLISHED API call - it may change in the future
+func (s *MetricDataService) GetBaselines(appID int) ([]*Baseline, error) {
+
+	url := fmt.Sprintf("controller/restui/baselines/getAllBaselines/%d", appID)
+
+	var baselines []*Baseline
+	err := s.client.RestInternal("GET", url, &baselines, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return baselines, nil
+}
+
+// GetBaselineByName returns the baseline with the given name, an empty name returns the default baseline
+func (s *MetricDataService) GetBaselineByName(appID int, name string) (*Baseline, error) {
+	baselines, err := s.GetBaselines(appID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, baseline := range baselines {
+		if (name == "" && baseline.DefaultBaseline) || (name != "" && baseline.Name == name) {
+			return baseline, nil
+		}
+	}
+
+	return nil, fmt.Errorf("Could not find Baseline with name: %q ", name)
+}
+
+// GetMetricDataWithBaseline obtains metrics matching the query and pairs every value with query.Baseline,
+// the default baseline of the application is used when query.Baseline is empty.
+// The controller returns baselines for one metric per request, so besides the metric-data call every
+// matched metric with values costs one more request. Wildcard paths matching more than
+// query.MaxBaselineMetrics metrics with values fail with ErrTooManyBaselineMetrics before any baseline is requested.
+// DANGER ZONE
+// this uses an UNPUBLISHED API call - it may change in the future
+func (s *MetricDataService) GetMetricDataWithBaseline(query MetricQuery, timeRangeType string, durationInMins int, startTime time.Time, endTime time.Time) ([]*MetricBaselineData, error) {
+
+	appID, err := s.resolveApplicationID(query.Application)
+	if err != nil {
+		return nil, err
+	}
+
+	baseline, err := s.GetBaselineByName(appID, query.Baseline)
+	if err != nil {
+		return nil, err
+	}
+
+	metrics, err := s.GetMetricData(query.Application, query.MetricPath, query.Rollup, timeRangeType, durationInMins, startTime, endTime)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := query.MaxBaselineMetrics
+	if limit <= 0 {
+		limit = DefaultMaxBaselineMetrics
+	}
+	withValues := 0
+	for _, metric := range metrics {
+		if len(metric.MetricValues) > 0 {
+			withValues++
+		}
+	}
+	if withValues > limit {
+		return nil, fmt.Errorf("%s matches %d metrics, limit is %d: %w", query.MetricPath, withValues, limit, ErrTooManyBaselineMetrics)
+	}
+
+	from, to := metricTimeRange(timeRangeType, durationInMins, startTime, endTime, time.Now())
+
+	var result []*MetricBaselineData
+	for _, metric := range metrics {
+		data := &MetricBaselineData{
+			MetricName: metric.MetricName,
+			MetricID:   metric.MetricID,
+			MetricPath: metric.MetricPath,
+			Frequency:  metric.Frequency,
+			Baseline:   baseline,
+		}
+
+		result = append(result, data)
+		if len(metric.MetricValues) == 0 {
+			continue
+		}
+
+		baselineValues, err := s.getBaselineValues(appID, baseline.ID, metric.MetricID, from, to)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, value := range metric.MetricValues {
+			paired := MetricValueBaseline{MetricValue: value}
+			if bv, ok := baselineValues[value.StartTimeInMillis]; ok {
+				paired.HasBaseline = true
+				paired.BaselineValue = bv[0]
+				paired.BaselineStandardDeviation = bv[1]
+			}
+			data.MetricValues = append(data.MetricValues, paired)
+		}
+	}
+
+	return result, nil
+}
+
+// getBaselineValues returns baseline value and standard deviation by minute start time
+func (s *MetricDataService) getBaselineValues(appID int, baselineID int, metricID int, from time.Time, to time.Time) (map[int64][2]float64, error) {
+
+	url := "controller/restui/metricBrowser/getMetricBaselineData?granularityMinutes=1"
+
+	body := metricBaselineRequest{
+		MetricID:      metricID,
+		ApplicationID: appID,
+		BaselineID:    baselineID,
+		TimeRangeSpecifier: metricBaselineTimeRangeSpec{
+			Type:              TimeBETWEENTIMES,
+			DurationInMinutes: int(to.Sub(from) / time.Minute),
+			StartTime:         from.UnixMilli(),
+			EndTime:           to.UnixMilli(),
+		},
+	}
+
+	var response *metricBaselineResponse
+	err := s.client.RestInternal("POST", url, &response, &body)
+	if err != nil {
+		return nil, fmt.Errorf("Baseline API: %v -> %s", err, url)
+	}
+
+	values := make(map[int64][2]float64)
+	if response == nil {
+		return values, nil
+	}
+	for _, slice := range response.DataTimeslices {
+		if slice.MetricValue != nil {
+			values[slice.StartTime] = [2]float64{slice.MetricValue.Value, slice.MetricValue.StandardDeviation}
+		}
+	}
+	return values, nil
+}
+
+// resolveApplicationID returns the numeric ID of an application name or ID
+func (s *MetricDataService) resolveApplicationID(appIDOrName string) (int, error) {
+	if id, err := strconv.Atoi(appIDOrName); err == nil {
+		return id, nil
+	}
+
+	app, err := s.client.Application.GetApplication(appIDOrName)
+	if err != nil {
+		return 0, err
+	}
+	return app.ID, nil
+}
+
+// metricTimeRange converts the metric-data time range arguments to absolute start and end times
+func metricTimeRange(timeRangeType string, durationInMins int, startTime time.Time, endTime time.Time, now time.Time) (time.Time, time.Time) {
+	duration := time.Duration(durationInMins) * time.Minute
+	switch timeRangeType {
+	case TimeBEFORETIME:
+		return endTime.Add(-duration), endTime
+	case TimeAFTERTIME:
+		return startTime, startTime.Add(duration)
+	case TimeBETWEENTIMES:
+		return startTime, endTime
+	}
+	return now.Add(-duration), now
+}
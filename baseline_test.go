@@ -0,0 +1,80 @@
+/*
+MIT License
+
+Copyright (c) 2026 Cisco Systems, Inc. and its affiliates
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+
+*/
+
+package appdrest
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestGetMetricDataWithBaselineLimit(t *testing.T) {
+	minute := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC).UnixMilli()
+	baselineRequests := 0
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/controller/rest/applications/1/metric-data":
+			json.NewEncoder(w).Encode([]MetricData{
+				{MetricID: 1, MetricPath: "a|web|ART", MetricValues: []MetricValue{{StartTimeInMillis: minute, Value: 120}}},
+				{MetricID: 2, MetricPath: "a|api|ART", MetricValues: []MetricValue{{StartTimeInMillis: minute, Value: 80}}},
+				{MetricID: 3, MetricPath: "a|batch|ART"}, // no data, needs no baseline
+			})
+		case "/controller/restui/baselines/getAllBaselines/1":
+			json.NewEncoder(w).Encode([]Baseline{{ID: 7, Name: "All data - Last 15 days", DefaultBaseline: true}})
+		case "/controller/restui/metricBrowser/getMetricBaselineData":
+			baselineRequests++
+			var request metricBaselineRequest
+			json.NewDecoder(r.Body).Decode(&request)
+			if request.BaselineID != 7 || request.ApplicationID != 1 {
+				t.Errorf("baseline request %+v", request)
+			}
+			w.Write([]byte(`{"dataTimeslices":[{"startTime":` + strconv.FormatInt(minute, 10) + `,"metricValue":{"value":100,"standardDeviation":10}}]}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+		}
+	}))
+
+	query := MetricQuery{Application: "1", MetricPath: "a|*|ART", MaxBaselineMetrics: 1}
+	_, err := client.MetricData.GetMetricDataWithBaseline(query, TimeBEFORENOW, 60, time.Time{}, time.Time{})
+	if !errors.Is(err, ErrTooManyBaselineMetrics) || baselineRequests != 0 {
+		t.Fatalf("limit 1: got %v after %d baseline requests", err, baselineRequests)
+	}
+
+	query.MaxBaselineMetrics = 2
+	data, err := client.MetricData.GetMetricDataWithBaseline(query, TimeBEFORENOW, 60, time.Time{}, time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if baselineRequests != 2 || len(data) != 3 {
+		t.Fatalf("%d baseline requests for %d metrics, want 2 for 3", baselineRequests, len(data))
+	}
+	if deviation := data[0].MetricValues[0].Deviation(); deviation != 2 {
+		t.Errorf("deviation %v, want 2", deviation)
+	}
+}
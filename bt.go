@@ -26,7 +26,11 @@ SOFTWARE.
 package appdrest
 
 import (
+	"bytes"
+	"encoding/xml"
+	"errors"
 	"fmt"
+	"regexp"
 )
 
 // BusinessTransaction represents one BT within one Application
@@ -40,6 +44,10 @@ type BusinessTransaction struct {
 	ID             int    `json:"id"`
 }
 
+// OverflowBusinessTransaction is the name of the BT collecting calls once the BT limit is reached
+// Added 2026 Cisco Systems, Inc.
+const OverflowBusinessTransaction = "_APPDYNAMICS_DEFAULT_TX_"
+
 // BusinessTransactionService intermediates BusinessTransaction requests
 type BusinessTransactionService service
 
@@ -56,3 +64,221 @@ func (s *BusinessTransactionService) GetBusinessTransactions(appID int) ([]*Busi
 
 	return bts, nil
 }
+
+// GetExcludedBusinessTransactions obtains the excluded BTs of an application
+// Added 2026 Cisco Systems, Inc.
+func (s *BusinessTransactionService) GetExcludedBusinessTransactions(appID int) ([]*BusinessTransaction, error) {
+
+	url := fmt.Sprintf("controller/rest/applications/%d/business-transactions?output=json&exclude=true", appID)
+
+	var bts []*BusinessTransaction
+	err := s.client.Rest("GET", url, &bts, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return bts, nil
+}
+
+// ExcludeBusinessTransactions excludes BTs so they are no longer tracked
+// Added 2026 Cisco Systems, Inc.
+func (s *BusinessTransactionService) ExcludeBusinessTransactions(appID int, btIDs []int) error {
+	return s.setExcluded(appID, btIDs, true)
+}
+
+// IncludeBusinessTransactions tracks previously excluded BTs again
+// Added 2026 Cisco Systems, Inc.
+func (s *BusinessTransactionService) IncludeBusinessTransactions(appID int, btIDs []int) error {
+	return s.setExcluded(appID, btIDs, false)
+}
+
+func (s *BusinessTransactionService) setExcluded(appID int, btIDs []int, exclude bool) error {
+
+	if len(btIDs) == 0 {
+		return errors.New("no business transactions given")
+	}
+
+	cfg := CfgBusinessTransactions{}
+	for _, id := range btIDs {
+		cfg.BusinessTransaction = append(cfg.BusinessTransaction, CfgBusinessTransaction{Id: id})
+	}
+	body, err := xml.Marshal(cfg)
+	if err != nil {
+		return err
+	}
+
+	url := fmt.Sprintf("controller/rest/applications/%d/business-transactions?exclude=%t", appID, exclude)
+
+	req, err := s.client.newRequestBodyBytes("POST", url, bytes.NewBuffer(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/xml")
+
+	err = s.client.do(req, nil, false)
+	if err != nil {
+		return fmt.Errorf("setting exclude=%t for %d business transactions: %w", exclude, len(btIDs), err)
+	}
+
+	return nil
+}
+
+// DANGER ZONE
+// following types are used for UNPUBLISHED api call and it may change in the future
+type businessTransactionRename struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+// DANGER ZONE END
+
+// DANGER ZONE
+// this is an UNPUBLISHED API call - it may change in the future
+
+// RenameBusinessTransaction renames a BT, metrics keep being collected under the new name
+// Added 2026 Cisco Systems, Inc.
+func (s *BusinessTransactionService) RenameBusinessTransaction(btID int, name string) error {
+
+	if name == "" {
+		return errors.New("empty business transaction name")
+	}
+
+	url := "controller/restui/bt/renameBT"
+
+	body := businessTransactionRename{ID: btID, Name: name}
+	err := s.client.RestInternal("POST", url, nil, &body)
+	if err != nil {
+		if fmt.Sprintf("%s", err) == "EOF" { // successful call returns EOF error -> empty body
+			return nil
+		}
+		return err
+	}
+
+	return nil
+}
+
+// DeleteBusinessTransactions deletes BTs with their metrics, BTs that still receive traffic are registered again
+// Added 2026 Cisco Systems, Inc.
+func (s *BusinessTransactionService) DeleteBusinessTransactions(btIDs []int) error {
+
+	if len(btIDs) == 0 {
+		return errors.New("no business transactions given")
+	}
+
+	url := "controller/restui/bt/deleteBTs"
+
+	err := s.client.RestInternal("POST", url, nil, btIDs)
+	if err != nil {
+		if fmt.Sprintf("%s", err) == "EOF" { // successful call returns EOF error -> empty body
+			return nil
+		}
+		return err
+	}
+
+	return nil
+}
+
+// DANGER ZONE END
+
+// ErrEmptyFilter is returned by bulk operations given a filter without criteria and without All
+// Added 2026 Cisco Systems, Inc.
+var ErrEmptyFilter = errors.New("empty filter, set All to select everything")
+
+// BusinessTransactionFilter selects BTs for bulk operations, empty fields match everything.
+// ExcludeMatching and DeleteMatching refuse a filter without any criteria unless All is set.
+// Added 2026 Cisco Systems, Inc.
+type BusinessTransactionFilter struct {
+	Tiers           []string       // tier names
+	EntryPointTypes []string       // e.g. "SERVLET", "POJO", "ASP_DOTNET"
+	Name            *regexp.Regexp // BT name pattern
+	Background      *bool          // only background or only foreground BTs
+	All             bool           // explicitly select every BT when no other field is set
+}
+
+// Empty reports whether the filter has no criteria and All is not set
+func (f BusinessTransactionFilter) Empty() bool {
+	return !f.All && len(f.Tiers) == 0 && len(f.EntryPointTypes) == 0 && f.Name == nil && f.Background == nil
+}
+
+// Match reports whether a BT is selected by the filter
+func (f BusinessTransactionFilter) Match(bt *BusinessTransaction) bool {
+	if len(f.Tiers) > 0 && !containsString(f.Tiers, bt.TierName) {
+		return false
+	}
+	if len(f.EntryPointTypes) > 0 && !containsString(f.EntryPointTypes, bt.EntryPointType) {
+		return false
+	}
+	if f.Name != nil && !f.Name.MatchString(bt.Name) {
+		return false
+	}
+	if f.Background != nil && *f.Background != bt.Background {
+		return false
+	}
+	return true
+}
+
+func containsString(list []string, s string) bool {
+	for _, candidate := range list {
+		if candidate == s {
+			return true
+		}
+	}
+	return false
+}
+
+// SelectBusinessTransactions returns the BTs of an application matching the filter
+// Added 2026 Cisco Systems, Inc.
+func (s *BusinessTransactionService) SelectBusinessTransactions(appID int, filter BusinessTransactionFilter) ([]*BusinessTransaction, error) {
+	bts, err := s.GetBusinessTransactions(appID)
+	if err != nil {
+		return nil, err
+	}
+
+	var selected []*BusinessTransaction
+	for _, bt := range bts {
+		// the REST API lists the overflow BT like any other, it can not be excluded or deleted
+		if bt.Name == OverflowBusinessTransaction {
+			continue
+		}
+		if filter.Match(bt) {
+			selected = append(selected, bt)
+		}
+	}
+	return selected, nil
+}
+
+// BusinessTransactionIDs returns the IDs of the BTs
+// Added 2026 Cisco Systems, Inc.
+func BusinessTransactionIDs(bts []*BusinessTransaction) []int {
+	ids := make([]int, len(bts))
+	for i, bt := range bts {
+		ids[i] = bt.ID
+	}
+	return ids
+}
+
+// ExcludeMatching excludes all BTs matching the filter and returns them, an empty filter returns ErrEmptyFilter
+// Added 2026 Cisco Systems, Inc.
+func (s *BusinessTransactionService) ExcludeMatching(appID int, filter BusinessTransactionFilter) ([]*BusinessTransaction, error) {
+	if filter.Empty() {
+		return nil, ErrEmptyFilter
+	}
+	bts, err := s.SelectBusinessTransactions(appID, filter)
+	if err != nil || len(bts) == 0 {
+		return bts, err
+	}
+	return bts, s.ExcludeBusinessTransactions(appID, BusinessTransactionIDs(bts))
+}
+
+// DeleteMatching deletes all BTs matching the filter and returns them, an empty filter returns ErrEmptyFilter
+// Added 2026 Cisco Systems, Inc.
+func (s *BusinessTransactionService) DeleteMatching(appID int, filter BusinessTransactionFilter) ([]*BusinessTransaction, error) {
+	if filter.Empty() {
+		return nil, ErrEmptyFilter
+	}
+	bts, err := s.SelectBusinessTransactions(appID, filter)
+	if err != nil || len(bts) == 0 {
+		return bts, err
+	}
+	return bts, s.DeleteBusinessTransactions(BusinessTransactionIDs(bts))
+}
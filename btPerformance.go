@@ -0,0 +1,271 @@
+/*
+MIT License
+
+Copyright (c) 2026 Cisco Systems, Inc. and its affiliates
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+
+*/
+
+package appdrest
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// HealthStatus is the worst severity of the open health rule violations of an entity
+type HealthStatus string
+
+// Consts for the health states, ordered from best to worst.
+// HealthUnknown is used when no enabled health rule evaluates the entity.
+const (
+	HealthUnknown  HealthStatus = "UNKNOWN"
+	HealthNormal   HealthStatus = "NORMAL"
+	HealthWarning  HealthStatus = "WARNING"
+	HealthCritical HealthStatus = "CRITICAL"
+)
+
+func (h HealthStatus) rank() int {
+	switch h {
+	case HealthCritical:
+		return 2
+	case HealthWarning:
+		return 1
+	case HealthNormal:
+		return 0
+	}
+	return -1
+}
+
+// BTHealthLookback is how long before the end of the time range GetPerformanceSummary looks for
+// violations that are still open, violations started earlier are not seen
+const BTHealthLookback = 24 * time.Hour
+
+// BTPerformance is the performance summary of one BT for a time range
+type BTPerformance struct {
+	BusinessTransaction *BusinessTransaction `json:"businessTransaction"`
+	CallsPerMinute      float64              `json:"callsPerMinute"`
+	AverageResponseTime float64              `json:"averageResponseTime"` // ms
+	ErrorsPerMinute     float64              `json:"errorsPerMinute"`
+	SlowCalls           float64              `json:"slowCalls"`
+	VerySlowCalls       float64              `json:"verySlowCalls"`
+	Stalls              float64              `json:"stalls"`
+	Health              HealthStatus         `json:"health"`
+}
+
+// ErrorRate returns the share of calls ending in an error
+func (p *BTPerformance) ErrorRate() float64 {
+	if p.CallsPerMinute == 0 {
+		return 0
+	}
+	return p.ErrorsPerMinute / p.CallsPerMinute
+}
+
+// BTPerformanceColumn names a column of the performance summary
+type BTPerformanceColumn string
+
+// Consts for the columns BTPerformanceSummary can be sorted by
+const (
+	BTColumnTier                BTPerformanceColumn = "tier"
+	BTColumnName                BTPerformanceColumn = "name"
+	BTColumnCallsPerMinute      BTPerformanceColumn = "calls"
+	BTColumnAverageResponseTime BTPerformanceColumn = "art"
+	BTColumnErrorsPerMinute     BTPerformanceColumn = "errors"
+	BTColumnSlowCalls           BTPerformanceColumn = "slow"
+	BTColumnVerySlowCalls       BTPerformanceColumn = "veryslow"
+	BTColumnStalls              BTPerformanceColumn = "stalls"
+	BTColumnHealth              BTPerformanceColumn = "health"
+)
+
+// BTPerformanceSummary holds one row per BT
+type BTPerformanceSummary []*BTPerformance
+
+// Sort sorts the rows by a column, ties are broken by tier and BT name
+func (rows BTPerformanceSummary) Sort(column BTPerformanceColumn, descending bool) error {
+	var less func(a, b *BTPerformance) bool
+	switch column {
+	case BTColumnTier:
+		less = func(a, b *BTPerformance) bool { return a.BusinessTransaction.TierName < b.BusinessTransaction.TierName }
+	case BTColumnName:
+		less = func(a, b *BTPerformance) bool { return a.BusinessTransaction.Name < b.BusinessTransaction.Name }
+	case BTColumnCallsPerMinute:
+		less = func(a, b *BTPerformance) bool { return a.CallsPerMinute < b.CallsPerMinute }
+	case BTColumnAverageResponseTime:
+		less = func(a, b *BTPerformance) bool { return a.AverageResponseTime < b.AverageResponseTime }
+	case BTColumnErrorsPerMinute:
+		less = func(a, b *BTPerformance) bool { return a.ErrorsPerMinute < b.ErrorsPerMinute }
+	case BTColumnSlowCalls:
+		less = func(a, b *BTPerformance) bool { return a.SlowCalls < b.SlowCalls }
+	case BTColumnVerySlowCalls:
+		less = func(a, b *BTPerformance) bool { return a.VerySlowCalls < b.VerySlowCalls }
+	case BTColumnStalls:
+		less = func(a, b *BTPerformance) bool { return a.Stalls < b.Stalls }
+	case BTColumnHealth:
+		less = func(a, b *BTPerformance) bool { return a.Health.rank() < b.Health.rank() }
+	default:
+		return fmt.Errorf("unknown BT performance column %q", column)
+	}
+
+	sort.SliceStable(rows, func(i, j int) bool {
+		a, b := rows[i], rows[j]
+		if less(a, b) {
+			return !descending
+		}
+		if less(b, a) {
+			return descending
+		}
+		if a.BusinessTransaction.TierName != b.BusinessTransaction.TierName {
+			return a.BusinessTransaction.TierName < b.BusinessTransaction.TierName
+		}
+		return a.BusinessTransaction.Name < b.BusinessTransaction.Name
+	})
+	return nil
+}
+
+// GetPerformanceSummary obtains calls, response time, errors, slow, very slow and stalled calls
+// and the health of every BT matching the filter. Metrics are read with one wildcard query per metric,
+// rate metrics are averaged over the time range and counts are summed.
+// Health is the state at the end of the time range: the worst severity of the BT violations open at that time,
+// looking back BTHealthLookback for violations opened before the range, or HealthUnknown when no enabled
+// health rule evaluates business transactions.
+func (s *BusinessTransactionService) GetPerformanceSummary(appID int, timeSpec TimeSpec, filter BusinessTransactionFilter) (BTPerformanceSummary, error) {
+	bts, err := s.SelectBusinessTransactions(appID, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := make(BTPerformanceSummary, 0, len(bts))
+	byKey := make(map[string]*BTPerformance, len(bts))
+	byID := make(map[int]*BTPerformance, len(bts))
+	for _, bt := range bts {
+		row := &BTPerformance{BusinessTransaction: bt, Health: HealthNormal}
+		rows = append(rows, row)
+		byKey[bt.TierName+"|"+bt.Name] = row
+		byID[bt.ID] = row
+	}
+	if len(rows) == 0 {
+		return rows, nil
+	}
+
+	// a single tier keeps the wildcard query from returning every BT of the application
+	tier := MetricPathWildcard
+	if len(filter.Tiers) == 1 {
+		tier = filter.Tiers[0]
+	}
+
+	metrics := []struct {
+		name  string
+		sum   bool
+		field func(*BTPerformance) *float64
+	}{
+		{MetricCallsPerMinute, false, func(p *BTPerformance) *float64 { return &p.CallsPerMinute }},
+		{MetricAverageResponseTime, false, func(p *BTPerformance) *float64 { return &p.AverageResponseTime }},
+		{MetricErrorsPerMinute, false, func(p *BTPerformance) *float64 { return &p.ErrorsPerMinute }},
+		{MetricSlowCalls, true, func(p *BTPerformance) *float64 { return &p.SlowCalls }},
+		{MetricVerySlowCalls, true, func(p *BTPerformance) *float64 { return &p.VerySlowCalls }},
+		{MetricStallCount, true, func(p *BTPerformance) *float64 { return &p.Stalls }},
+	}
+	for _, metric := range metrics {
+		path := BusinessTransactionMetricPath(tier, MetricPathWildcard, metric.name)
+		data, err := s.client.MetricData.GetMetricData(strconv.Itoa(appID), path.String(), true,
+			timeSpec.Type, timeSpec.DurationInMins, timeSpec.StartTime, timeSpec.EndTime)
+		if err != nil {
+			return nil, err
+		}
+		for _, metricData := range data {
+			entities := metricData.Path().Entities()
+			row := byKey[entities.Tier+"|"+entities.BusinessTransaction]
+			if row == nil || len(metricData.MetricValues) == 0 {
+				continue
+			}
+			*metric.field(row) = rolledUpValue(metricData.MetricValues, metric.sum)
+		}
+	}
+
+	err = s.setHealth(appID, timeSpec, byID)
+	if err != nil {
+		return nil, err
+	}
+
+	return rows, nil
+}
+
+// setHealth sets the health of the rows at the end of the time range
+func (s *BusinessTransactionService) setHealth(appID int, timeSpec TimeSpec, byID map[int]*BTPerformance) error {
+	rules, err := s.client.HealthRule.GetHealthRules(appID)
+	if err != nil {
+		return err
+	}
+	evaluated := false
+	for _, rule := range rules {
+		if rule.Enabled && strings.HasPrefix(rule.AffectedEntityType, "BUSINESS_TRANSACTION") {
+			evaluated = true
+		}
+	}
+	if !evaluated {
+		for _, row := range byID {
+			row.Health = HealthUnknown
+		}
+		return nil
+	}
+
+	from, to := metricTimeRange(timeSpec.Type, timeSpec.DurationInMins, timeSpec.StartTime, timeSpec.EndTime, time.Now())
+	if lookback := to.Add(-BTHealthLookback); lookback.Before(from) {
+		from = lookback
+	}
+	violations, err := s.client.HealthRule.GetHealthRuleViolations(appID, TimeBETWEENTIMES, 0, from, to)
+	if err != nil {
+		return err
+	}
+	for _, violation := range violations {
+		if violation.AffectedEntityDefinition.EntityType != "BUSINESS_TRANSACTION" || violation.StartTimeInMillis > to.UnixMilli() {
+			continue
+		}
+		// resolved violations count when they ended after the time range
+		if !violation.Open() && violation.EndTimeInMillis <= to.UnixMilli() {
+			continue
+		}
+		row := byID[violation.AffectedEntityDefinition.EntityID]
+		severity := HealthStatus(strings.ToUpper(violation.Severity))
+		if row != nil && severity.rank() > row.Health.rank() {
+			row.Health = severity
+		}
+	}
+	return nil
+}
+
+// rolledUpValue combines the values of a metric, a rolled up query normally returns a single one
+func rolledUpValue(values []MetricValue, sum bool) float64 {
+	total := 0.0
+	for _, value := range values {
+		if sum {
+			total += float64(value.Sum)
+		} else {
+			total += float64(value.Value)
+		}
+	}
+	if sum || len(values) == 0 {
+		return total
+	}
+	return total / float64(len(values))
+}
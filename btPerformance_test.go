@@ -0,0 +1,115 @@
+/*
+MIT License
+
+Copyright (c) 2026 Cisco Systems, Inc. and its affiliates
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+
+*/
+
+package appdrest
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestGetPerformanceSummary(t *testing.T) {
+	end := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	start := end.Add(-time.Hour)
+	ms := func(t time.Time) int64 { return t.UnixMilli() }
+
+	rules := []HealthRule{{ID: 1, Name: "BT response time", Enabled: true, AffectedEntityType: "BUSINESS_TRANSACTION_PERFORMANCE"}}
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/controller/rest/applications/1/business-transactions":
+			json.NewEncoder(w).Encode([]BusinessTransaction{
+				{ID: 10, Name: "/checkout", TierName: "web"},
+				{ID: 11, Name: "/cart", TierName: "web"},
+				{ID: 12, Name: "/search", TierName: "web"},
+			})
+		case "/controller/rest/applications/1/metric-data":
+			path := r.URL.Query().Get("metric-path")
+			var values []MetricValue
+			switch {
+			case strings.HasSuffix(path, MetricCallsPerMinute):
+				values = []MetricValue{{Value: 10}, {Value: 11}}
+			case strings.HasSuffix(path, MetricSlowCalls):
+				values = []MetricValue{{Sum: 3}, {Sum: 4}}
+			}
+			json.NewEncoder(w).Encode([]MetricData{{
+				MetricPath:   strings.Replace(path, "|*|*|", "|web|/checkout|", 1),
+				MetricValues: values,
+			}})
+		case "/controller/alerting/rest/v1/applications/1/health-rules":
+			json.NewEncoder(w).Encode(rules)
+		case "/controller/rest/applications/1/problems/healthrule-violations":
+			if from := r.URL.Query().Get("start-time"); from != strconv.FormatInt(ms(end.Add(-BTHealthLookback)), 10) {
+				t.Errorf("violations requested from %s", from)
+			}
+			violation := func(id int, severity string, status string, from time.Time, to time.Time) HealthRuleViolation {
+				v := HealthRuleViolation{Severity: severity, IncidentStatus: status, StartTimeInMillis: ms(from), EndTimeInMillis: ms(to)}
+				v.AffectedEntityDefinition.EntityType = "BUSINESS_TRANSACTION"
+				v.AffectedEntityDefinition.EntityID = id
+				return v
+			}
+			json.NewEncoder(w).Encode([]HealthRuleViolation{
+				violation(10, "CRITICAL", "OPEN", end.Add(-5*time.Hour), time.Time{}),               // opened before the range
+				violation(11, "CRITICAL", "RESOLVED", start, start.Add(10*time.Minute)),             // resolved within the range
+				violation(11, "WARNING", "RESOLVED", start.Add(30*time.Minute), end.Add(time.Hour)), // resolved after the range
+				violation(12, "CRITICAL", "OPEN", end.Add(time.Minute), time.Time{}),                // opened after the range
+			})
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+		}
+	}))
+
+	rows, err := client.BusinessTransaction.GetPerformanceSummary(1, BetweenTimes(start, end), BusinessTransactionFilter{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	health := make(map[string]HealthStatus)
+	for _, row := range rows {
+		health[row.BusinessTransaction.Name] = row.Health
+		if row.BusinessTransaction.Name == "/checkout" && (row.CallsPerMinute != 10.5 || row.SlowCalls != 7) {
+			t.Errorf("/checkout calls %v slow %v, want 10.5 and 7", row.CallsPerMinute, row.SlowCalls)
+		}
+	}
+	want := map[string]HealthStatus{"/checkout": HealthCritical, "/cart": HealthWarning, "/search": HealthNormal}
+	for name, status := range want {
+		if health[name] != status {
+			t.Errorf("%s health %s, want %s", name, health[name], status)
+		}
+	}
+
+	rules[0].Enabled = false
+	rows, err = client.BusinessTransaction.GetPerformanceSummary(1, BetweenTimes(start, end), BusinessTransactionFilter{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, row := range rows {
+		if row.Health != HealthUnknown {
+			t.Errorf("%s health %s without BT health rules, want %s", row.BusinessTransaction.Name, row.Health, HealthUnknown)
+		}
+	}
+}
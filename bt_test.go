@@ -0,0 +1,69 @@
+/*
+MIT License
+
+Copyright (c) 2026 Cisco Systems, Inc. and its affiliates
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+
+*/
+
+package appdrest
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestDeleteMatchingEmptyFilter(t *testing.T) {
+	var deleted []int
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/controller/rest/applications/1/business-transactions":
+			json.NewEncoder(w).Encode([]*BusinessTransaction{
+				{ID: 10, Name: "/checkout"},
+				{ID: 11, Name: OverflowBusinessTransaction},
+			})
+		case "/controller/restui/bt/deleteBTs":
+			json.NewDecoder(r.Body).Decode(&deleted)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+		}
+	}))
+
+	if _, err := client.BusinessTransaction.DeleteMatching(1, BusinessTransactionFilter{}); !errors.Is(err, ErrEmptyFilter) {
+		t.Fatalf("empty filter: got %v, want ErrEmptyFilter", err)
+	}
+	if _, err := client.BusinessTransaction.ExcludeMatching(1, BusinessTransactionFilter{}); !errors.Is(err, ErrEmptyFilter) {
+		t.Fatalf("empty filter: got %v, want ErrEmptyFilter", err)
+	}
+	if deleted != nil {
+		t.Fatalf("empty filter deleted %v", deleted)
+	}
+
+	bts, err := client.BusinessTransaction.DeleteMatching(1, BusinessTransactionFilter{All: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(bts) != 1 || !reflect.DeepEqual(deleted, []int{10}) {
+		t.Errorf("All deleted %v, want [10] without the overflow BT", deleted)
+	}
+}
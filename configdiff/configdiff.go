@@ -0,0 +1,358 @@
+/*
+MIT License
+
+Copyright (c) 2026 Cisco Systems, Inc. and its affiliates
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+
+*/
+
+package configdiff
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"strings"
+
+	appdrest "github.com/cisco-open/appd-client-go"
+)
+
+// ChangeType tells how an object or field differs
+type ChangeType string
+
+// Consts for the change types
+const (
+	Added    ChangeType = "ADDED"    // only present on the right side
+	Removed  ChangeType = "REMOVED"  // only present on the left side
+	Modified ChangeType = "MODIFIED" // present on both sides with different values
+)
+
+// Consts for the kinds of compared objects
+const (
+	KindApplication         = "application"
+	KindTier                = "tier"
+	KindBusinessTransaction = "business-transaction"
+	KindCustomMatchPoint    = "custom-match-point"
+	KindErrorConfiguration  = "error-configuration"
+	KindDataCollector       = "data-collector"
+	KindPolicy              = "policy"
+	KindConfigSection       = "config-section"
+	KindHealthRule          = "health-rule"
+	KindDetectionRule       = "transaction-detection-rule"
+	KindDashboard           = "tier-dashboard"
+)
+
+// DefaultIgnoredFields are volatile fields left out of comparisons, matched case-insensitively
+var DefaultIgnoredFields = []string{
+	"id", "version", "guid", "accountId", "applicationId", "dashboardId",
+	"createdBy", "createdOn", "modifiedBy", "modifiedOn", "updatedOn", "controller-version",
+}
+
+// Options control the comparison
+type Options struct {
+	IgnoredFields []string // defaults to DefaultIgnoredFields
+}
+
+// Change is one difference between two snapshots
+type Change struct {
+	Kind   string      `json:"kind"`
+	Object string      `json:"object"`
+	Path   string      `json:"path,omitempty"` // field inside the object, empty for added or removed objects
+	Type   ChangeType  `json:"type"`
+	Left   interface{} `json:"left,omitempty"`
+	Right  interface{} `json:"right,omitempty"`
+}
+
+// String returns a human-readable description of the change
+func (c Change) String() string {
+	object := fmt.Sprintf("%s %q", c.Kind, c.Object)
+	if c.Path == "" {
+		return fmt.Sprintf("%s %s", strings.ToLower(string(c.Type)), object)
+	}
+	switch c.Type {
+	case Added:
+		return fmt.Sprintf("%s: %s added: %s", object, c.Path, short(c.Right))
+	case Removed:
+		return fmt.Sprintf("%s: %s removed: %s", object, c.Path, short(c.Left))
+	}
+	return fmt.Sprintf("%s: %s changed: %s -> %s", object, c.Path, short(c.Left), short(c.Right))
+}
+
+// Result holds all differences between a left and a right snapshot
+type Result struct {
+	Left    string   `json:"left"`
+	Right   string   `json:"right"`
+	Changes []Change `json:"changes"`
+}
+
+// Empty reports whether both snapshots are equal
+func (r *Result) Empty() bool {
+	return len(r.Changes) == 0
+}
+
+// WriteText writes one line per change
+func (r *Result) WriteText(w io.Writer) error {
+	_, err := fmt.Fprintf(w, "--- %s\n+++ %s\n", r.Left, r.Right)
+	if err != nil {
+		return err
+	}
+	for _, change := range r.Changes {
+		_, err = fmt.Fprintln(w, change.String())
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// WriteJSON writes the result as indented JSON
+func (r *Result) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(r)
+}
+
+// Compare returns the differences between two snapshots
+func Compare(left *Snapshot, right *Snapshot, opts Options) *Result {
+	ignored := opts.IgnoredFields
+	if ignored == nil {
+		ignored = DefaultIgnoredFields
+	}
+	d := &differ{ignored: make(map[string]bool)}
+	for _, field := range ignored {
+		d.ignored[strings.ToLower(field)] = true
+	}
+
+	r := &Result{Left: left.Controller + "/" + left.Application, Right: right.Controller + "/" + right.Application}
+
+	lc, rc := left.Config, right.Config
+	if lc == nil {
+		lc = &appdrest.ApplicationConfig{}
+	}
+	if rc == nil {
+		rc = &appdrest.ApplicationConfig{}
+	}
+	if lc.Description != rc.Description {
+		r.Changes = append(r.Changes, Change{Kind: KindApplication, Object: right.Application, Path: "description", Type: Modified, Left: lc.Description, Right: rc.Description})
+	}
+	r.Changes = append(r.Changes, d.objects(KindTier, keyed(lc.Tiers, func(t appdrest.ConfigTier) string { return t.Name }), keyed(rc.Tiers, func(t appdrest.ConfigTier) string { return t.Name }))...)
+	r.Changes = append(r.Changes, d.objects(KindBusinessTransaction, keyed(lc.BusinessTransactions, btKey), keyed(rc.BusinessTransactions, btKey))...)
+	r.Changes = append(r.Changes, d.objects(KindCustomMatchPoint, keyed(lc.CustomMatchPoints, func(m appdrest.ConfigCustomMatchPoint) string { return m.Name }), keyed(rc.CustomMatchPoints, func(m appdrest.ConfigCustomMatchPoint) string { return m.Name }))...)
+	r.Changes = append(r.Changes, d.objects(KindErrorConfiguration, keyed(lc.ErrorConfigurations, func(e appdrest.ConfigErrorConfiguration) string { return e.AgentType }), keyed(rc.ErrorConfigurations, func(e appdrest.ConfigErrorConfiguration) string { return e.AgentType }))...)
+	r.Changes = append(r.Changes, d.objects(KindDataCollector, keyed(lc.DataCollectors, func(c appdrest.ConfigDataCollector) string { return c.Name }), keyed(rc.DataCollectors, func(c appdrest.ConfigDataCollector) string { return c.Name }))...)
+	r.Changes = append(r.Changes, d.objects(KindPolicy, keyed(lc.Policies, func(p appdrest.ConfigPolicy) string { return p.Name }), keyed(rc.Policies, func(p appdrest.ConfigPolicy) string { return p.Name }))...)
+	r.Changes = append(r.Changes, d.objects(KindConfigSection, keyed(lc.Other, sectionKey), keyed(rc.Other, sectionKey))...)
+	r.Changes = append(r.Changes, d.objects(KindHealthRule, toInterfaces(left.HealthRules), toInterfaces(right.HealthRules))...)
+	r.Changes = append(r.Changes, d.objects(KindDetectionRule, toInterfaces(left.DetectionRules), toInterfaces(right.DetectionRules))...)
+	r.Changes = append(r.Changes, d.objects(KindDashboard, toInterfaces(left.Dashboards), toInterfaces(right.Dashboards))...)
+
+	return r
+}
+
+func btKey(bt appdrest.ConfigBusinessTransaction) string {
+	return bt.Tier + "/" + bt.Name
+}
+
+func sectionKey(e appdrest.ConfigElement) string {
+	return e.XMLName.Local
+}
+
+// keyed indexes a slice by key, duplicate keys get a numeric suffix
+func keyed[T any](items []T, key func(T) string) map[string]interface{} {
+	m := make(map[string]interface{}, len(items))
+	for _, item := range items {
+		k := key(item)
+		for i := 2; m[k] != nil; i++ {
+			k = fmt.Sprintf("%s#%d", key(item), i)
+		}
+		m[k] = item
+	}
+	return m
+}
+
+func toInterfaces[T any](in map[string]T) map[string]interface{} {
+	m := make(map[string]interface{}, len(in))
+	for k, v := range in {
+		m[k] = v
+	}
+	return m
+}
+
+type differ struct {
+	ignored map[string]bool
+}
+
+// objects compares two sets of named objects
+func (d *differ) objects(kind string, left map[string]interface{}, right map[string]interface{}) []Change {
+	var changes []Change
+	for _, name := range unionKeys(left, right) {
+		l, inLeft := left[name]
+		r, inRight := right[name]
+		switch {
+		case !inLeft:
+			changes = append(changes, Change{Kind: kind, Object: name, Type: Added, Right: d.normalize(r)})
+		case !inRight:
+			changes = append(changes, Change{Kind: kind, Object: name, Type: Removed, Left: d.normalize(l)})
+		default:
+			for _, c := range d.values("", d.normalize(l), d.normalize(r)) {
+				c.Kind = kind
+				c.Object = name
+				changes = append(changes, c)
+			}
+		}
+	}
+	return changes
+}
+
+// normalize converts a value to its JSON form without ignored fields
+func (d *differ) normalize(v interface{}) interface{} {
+	body, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprintf("%v", v)
+	}
+	var generic interface{}
+	err = json.Unmarshal(body, &generic)
+	if err != nil {
+		return string(body)
+	}
+	return d.strip(generic)
+}
+
+func (d *differ) strip(v interface{}) interface{} {
+	switch value := v.(type) {
+	case map[string]interface{}:
+		// xml.Attr serializes as {"Name": {"Local": ...}, "Value": ...}
+		if name, ok := value["Name"].(map[string]interface{}); ok && len(value) == 2 {
+			if local, ok := name["Local"].(string); ok && d.ignored[strings.ToLower(local)] {
+				return nil
+			}
+		}
+		out := make(map[string]interface{}, len(value))
+		for k, child := range value {
+			if d.ignored[strings.ToLower(k)] {
+				continue
+			}
+			if k == "Content" {
+				if s, ok := child.(string); ok {
+					child = strings.Join(strings.Fields(s), " ")
+				}
+			}
+			out[k] = d.strip(child)
+		}
+		return out
+	case []interface{}:
+		out := make([]interface{}, 0, len(value))
+		for _, child := range value {
+			if stripped := d.strip(child); stripped != nil {
+				out = append(out, stripped)
+			}
+		}
+		return out
+	}
+	return v
+}
+
+// values compares two normalized values recursively
+func (d *differ) values(path string, left interface{}, right interface{}) []Change {
+	if reflect.DeepEqual(left, right) {
+		return nil
+	}
+
+	lm, lok := left.(map[string]interface{})
+	rm, rok := right.(map[string]interface{})
+	if lok && rok {
+		var changes []Change
+		for _, key := range unionKeys(lm, rm) {
+			l, inLeft := lm[key]
+			r, inRight := rm[key]
+			child := join(path, key)
+			switch {
+			case !inLeft:
+				changes = append(changes, Change{Path: child, Type: Added, Right: r})
+			case !inRight:
+				changes = append(changes, Change{Path: child, Type: Removed, Left: l})
+			default:
+				changes = append(changes, d.values(child, l, r)...)
+			}
+		}
+		return changes
+	}
+
+	ls, lok := left.([]interface{})
+	rs, rok := right.([]interface{})
+	if lok && rok {
+		var changes []Change
+		for i := 0; i < len(ls) || i < len(rs); i++ {
+			child := fmt.Sprintf("%s[%d]", path, i)
+			switch {
+			case i >= len(ls):
+				changes = append(changes, Change{Path: child, Type: Added, Right: rs[i]})
+			case i >= len(rs):
+				changes = append(changes, Change{Path: child, Type: Removed, Left: ls[i]})
+			default:
+				changes = append(changes, d.values(child, ls[i], rs[i])...)
+			}
+		}
+		return changes
+	}
+
+	if path == "" {
+		path = "."
+	}
+	return []Change{{Path: path, Type: Modified, Left: left, Right: right}}
+}
+
+func join(path string, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+func unionKeys(left map[string]interface{}, right map[string]interface{}) []string {
+	var keys []string
+	for k := range left {
+		keys = append(keys, k)
+	}
+	for k := range right {
+		if _, ok := left[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func short(v interface{}) string {
+	var buf strings.Builder
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	err := enc.Encode(v)
+	if err != nil {
+		return fmt.Sprintf("%v", v)
+	}
+	s := strings.TrimSpace(buf.String())
+	if len(s) > 120 {
+		s = s[:117] + "..."
+	}
+	return s
+}
@@ -0,0 +1,168 @@
+/*
+MIT License
+
+Copyright (c) 2026 Cisco Systems, Inc. and its affiliates
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+
+*/
+
+package configdiff
+
+import (
+	"reflect"
+	"testing"
+
+	appdrest "github.com/cisco-open/appd-client-go"
+)
+
+const leftConfig = `<application controller-version="004-005-018-000">
+    <name>shop</name>
+    <description>storefront</description>
+    <application-components>
+        <application-component><name>web</name><component-type>JAVA_APPLICATION_SERVER</component-type></application-component>
+        <application-component><name>batch</name><component-type>JAVA_APPLICATION_SERVER</component-type></application-component>
+    </application-components>
+    <business-transactions>
+        <business-transaction transaction-type="SERVLET"><name>/pay</name><application-component>web</application-component><background>false</background></business-transaction>
+        <business-transaction transaction-type="SERVLET"><name>/pay</name><application-component>batch</application-component></business-transaction>
+    </business-transactions>
+    <eum-cloud-config>
+        <eum-app-key>AD-1</eum-app-key>
+    </eum-cloud-config>
+</application>`
+
+const rightConfig = `<application controller-version="020-010-000-000">
+    <name>shop</name>
+    <description>storefront</description>
+    <application-components>
+        <application-component><name>web</name><component-type>NODEJS_APPLICATION_SERVER</component-type></application-component>
+        <application-component><name>api</name><component-type>JAVA_APPLICATION_SERVER</component-type></application-component>
+    </application-components>
+    <business-transactions>
+        <business-transaction transaction-type="SERVLET"><name>/pay</name><application-component>web</application-component><background>true</background></business-transaction>
+    </business-transactions>
+    <eum-cloud-config><eum-app-key>AD-1</eum-app-key></eum-cloud-config>
+</application>`
+
+func parse(t *testing.T, body string) *appdrest.ApplicationConfig {
+	t.Helper()
+	config, err := appdrest.ParseApplicationConfig([]byte(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return config
+}
+
+func intPtr(i int) *int {
+	return &i
+}
+
+func strPtr(s string) *string {
+	return &s
+}
+
+func TestCompareConfig(t *testing.T) {
+	left := &Snapshot{Controller: "a", Application: "shop", Config: parse(t, leftConfig)}
+	right := &Snapshot{Controller: "b", Application: "shop", Config: parse(t, rightConfig)}
+
+	result := Compare(left, right, Options{})
+	var got []string
+	for _, change := range result.Changes {
+		got = append(got, change.String())
+	}
+	// the controller-version attribute is ignored and the EUM section only differs in whitespace
+	want := []string{
+		`added tier "api"`,
+		`removed tier "batch"`,
+		`tier "web": ComponentType changed: "JAVA_APPLICATION_SERVER" -> "NODEJS_APPLICATION_SERVER"`,
+		`removed business-transaction "batch//pay"`,
+		`business-transaction "web//pay": Background changed: false -> true`,
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("changes\n%q\nwant\n%q", got, want)
+	}
+	if result.Left != "a/shop" || result.Right != "b/shop" {
+		t.Errorf("compared %s with %s", result.Left, result.Right)
+	}
+	if !Compare(left, left, Options{}).Empty() {
+		t.Errorf("snapshot differs from itself")
+	}
+}
+
+func TestCompareIgnoredFields(t *testing.T) {
+	left := &Snapshot{HealthRules: map[string]*appdrest.HealthRuleDetail{
+		"cpu": {ID: intPtr(1), Name: strPtr("cpu"), WaitTimeAfterViolation: intPtr(30)},
+	}}
+	right := &Snapshot{HealthRules: map[string]*appdrest.HealthRuleDetail{
+		"cpu": {ID: intPtr(7), Name: strPtr("cpu"), WaitTimeAfterViolation: intPtr(30)},
+	}}
+	if result := Compare(left, right, Options{}); !result.Empty() {
+		t.Errorf("IDs compared: %v", result.Changes)
+	}
+
+	right.HealthRules["cpu"].WaitTimeAfterViolation = intPtr(60)
+	want := []Change{{Kind: KindHealthRule, Object: "cpu", Path: "waitTimeAfterViolation", Type: Modified, Left: 30.0, Right: 60.0}}
+	if result := Compare(left, right, Options{}); !reflect.DeepEqual(result.Changes, want) {
+		t.Errorf("changes %+v, want %+v", result.Changes, want)
+	}
+
+	result := Compare(left, right, Options{IgnoredFields: []string{"WAITTIMEAFTERVIOLATION"}})
+	if len(result.Changes) != 1 || result.Changes[0].Path != "id" {
+		t.Errorf("changes with IDs compared and the wait time ignored %+v", result.Changes)
+	}
+}
+
+func TestKeyedDuplicates(t *testing.T) {
+	tiers := []appdrest.ConfigTier{{Name: "web", Description: "a"}, {Name: "web", Description: "b"}, {Name: "api"}}
+	got := keyed(tiers, func(t appdrest.ConfigTier) string { return t.Name })
+	if len(got) != 3 || got["web"].(appdrest.ConfigTier).Description != "a" || got["web#2"].(appdrest.ConfigTier).Description != "b" {
+		t.Errorf("keyed %v", got)
+	}
+}
+
+func TestDetectionRules(t *testing.T) {
+	mapping := func(name string, scopes ...string) appdrest.TxRuleScopeSummaryMappings {
+		m := appdrest.TxRuleScopeSummaryMappings{Rule: appdrest.TxRule{Summary: appdrest.TxRuleSummary{Name: name}}}
+		for _, scope := range scopes {
+			m.ScopeSummaries = append(m.ScopeSummaries, appdrest.TxRuleScopeSummaries{Name: scope})
+		}
+		return m
+	}
+	response := &appdrest.TxRulesResponse{RuleScopeSummaryMappings: []appdrest.TxRuleScopeSummaryMappings{
+		mapping("checkout", "Default Scope"),
+		mapping("checkout", "web only"),
+		mapping("checkout", "web only"),
+		mapping("login", "web only", "Default Scope"),
+	}}
+
+	rules := detectionRules(response)
+	var keys []string
+	for key := range rules {
+		keys = append(keys, key)
+	}
+	for _, key := range []string{"Default Scope/checkout", "web only/checkout", "web only/checkout#2", "Default Scope,web only/login"} {
+		if rules[key] == nil {
+			t.Errorf("%s missing from %q", key, keys)
+		}
+	}
+	if len(rules) != 4 {
+		t.Errorf("rules %q", keys)
+	}
+}
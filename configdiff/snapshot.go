@@ -0,0 +1,129 @@
+/*
+MIT License
+
+Copyright (c) 2026 Cisco Systems, Inc. and its affiliates
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+
+*/
+
+// Package configdiff compares the configuration of two applications,
+// possibly on different controllers, ignoring volatile fields such as IDs and versions.
+package configdiff
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	appdrest "github.com/cisco-open/appd-client-go"
+)
+
+// Snapshot is the comparable configuration of one application
+type Snapshot struct {
+	Controller     string
+	Application    string
+	Config         *appdrest.ApplicationConfig
+	HealthRules    map[string]*appdrest.HealthRuleDetail // by rule name
+	DetectionRules map[string]*appdrest.TxRule           // by "scope/rule", see detectionRules
+	Dashboards     map[string]*appdrest.DashboardExport  // tier dashboards by "tier/dashboard"
+}
+
+// Collect reads the configuration of an application from a controller
+func Collect(client *appdrest.Client, appID int) (*Snapshot, error) {
+	snapshot := &Snapshot{
+		Controller:     client.Controller.Host,
+		Application:    strconv.Itoa(appID),
+		HealthRules:    make(map[string]*appdrest.HealthRuleDetail),
+		DetectionRules: make(map[string]*appdrest.TxRule),
+		Dashboards:     make(map[string]*appdrest.DashboardExport),
+	}
+
+	config, err := client.Application.GetApplicationConfig(appID)
+	if err != nil {
+		return nil, fmt.Errorf("exporting application %d: %v", appID, err)
+	}
+	snapshot.Config = config
+	if config.Name != "" {
+		snapshot.Application = config.Name
+	}
+
+	rules, err := client.HealthRule.GetHealthRules(appID)
+	if err != nil {
+		return nil, fmt.Errorf("listing health rules: %v", err)
+	}
+	for _, rule := range rules {
+		detail, err := client.HealthRule.GetHealthRuleDetails(appID, rule.ID)
+		if err != nil {
+			return nil, fmt.Errorf("reading health rule %s: %v", rule.Name, err)
+		}
+		snapshot.HealthRules[rule.Name] = detail
+	}
+
+	detection, err := client.TxDetectionRule.GetTransactionDetectionRules(strconv.Itoa(appID))
+	if err != nil {
+		return nil, fmt.Errorf("listing transaction detection rules: %v", err)
+	}
+	if detection != nil {
+		snapshot.DetectionRules = detectionRules(detection)
+	}
+
+	tiers, err := client.Tier.GetTiers(appID)
+	if err != nil {
+		return nil, fmt.Errorf("listing tiers: %v", err)
+	}
+	for _, tier := range tiers {
+		dashboards, err := client.Dashboard.GetDashboardListForTier(tier.ID)
+		if err != nil {
+			return nil, fmt.Errorf("listing dashboards of tier %s: %v", tier.Name, err)
+		}
+		for _, dashboard := range dashboards {
+			export, err := client.Dashboard.GetDashboardExport(dashboard.ID)
+			if err != nil {
+				return nil, fmt.Errorf("exporting dashboard %s: %v", dashboard.Name, err)
+			}
+			snapshot.Dashboards[tier.Name+"/"+dashboard.Name] = export
+		}
+	}
+
+	return snapshot, nil
+}
+
+// detectionRules indexes transaction detection rules by "scope/rule", rule names are only unique within a scope.
+// A rule in several scopes is keyed by its sorted scope names, remaining duplicates get a numeric suffix.
+func detectionRules(response *appdrest.TxRulesResponse) map[string]*appdrest.TxRule {
+	rules := make(map[string]*appdrest.TxRule, len(response.RuleScopeSummaryMappings))
+	for i := range response.RuleScopeSummaryMappings {
+		mapping := &response.RuleScopeSummaryMappings[i]
+		var scopes []string
+		for _, scope := range mapping.ScopeSummaries {
+			scopes = append(scopes, scope.Name)
+		}
+		sort.Strings(scopes)
+
+		base := strings.Join(scopes, ",") + "/" + mapping.Rule.Summary.Name
+		key := base
+		for n := 2; rules[key] != nil; n++ {
+			key = fmt.Sprintf("%s#%d", base, n)
+		}
+		rules[key] = &mapping.Rule
+	}
+	return rules
+}
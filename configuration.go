@@ -120,23 +120,22 @@ type Configuration service
 		return 200, nil
 	}
 
-	func (c *Configuration) ExIncludeBTs(appId string, excl bool, b CfgBusinessTransactions) (int, error) {
-		url := fmt.Sprintf("controller/rest/applications/%s/business-transactions?exclude=%t", appId, excl)
-
-		err := c.client.Rest("POST", url, nil, b) // we need to unmarshal the object
-		if err != nil {
-			return 500, err
-		}
-
-		return 200, nil
-	}
+	ExIncludeBTs moved to BusinessTransactionService.ExcludeBusinessTransactions and IncludeBusinessTransactions
 */
+
+// MarkNodeHistorical marks nodes given as comma separated IDs as historical
+//
+// Deprecated: use NodeService.MarkNodesHistorical which takes typed IDs
+// Modified by 2026 Cisco Systems, Inc.
 func (c *Configuration) MarkNodeHistorical(nodes string) (int, error) {
 	url := fmt.Sprintf("controller/rest/mark-nodes-historical?application-component-node-ids=%s", nodes)
-	fmt.Println("The url is ", url)
 	err := c.client.Rest("POST", url, nil, nil)
 	if err != nil {
-		return 500, err
+		var apiErr *APIError
+		if errors.As(err, &apiErr) {
+			return apiErr.Code, err
+		}
+		return 0, err
 	}
 
 	return 200, nil
@@ -0,0 +1,88 @@
+/*
+MIT License
+
+Copyright (c) 2026 Cisco Systems, Inc. and its affiliates
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+
+*/
+
+package appdrest
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+)
+
+func TestReplaceDashboard(t *testing.T) {
+	tests := []struct {
+		name        string
+		upload      string
+		wantErr     bool
+		wantDeleted bool
+		wantRename  string
+	}{
+		{"rejected", `{"success":false,"errors":["bad widget"]}`, true, false, ""},
+		{"imported under new name", `{"success":true,"dashboard":{"id":12,"name":"Ops_1"},"createdDashboardName":"Ops_1"}`, false, true, "Ops"},
+	}
+	for _, test := range tests {
+		deleted, renamed := false, ""
+		client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			switch r.URL.Path {
+			case "/controller/CustomDashboardImportExportServlet":
+				if deleted {
+					t.Errorf("%s: dashboard deleted before the import", test.name)
+				}
+				io.WriteString(w, test.upload)
+			case "/controller/restui/dashboards/deleteDashboards":
+				var ids []int
+				json.NewDecoder(r.Body).Decode(&ids)
+				if len(ids) != 1 || ids[0] != 5 {
+					t.Errorf("%s: deleted %v, want [5]", test.name, ids)
+				}
+				deleted = true
+			case "/controller/restui/dashboards/dashboardIfUpdated/12/-1":
+				io.WriteString(w, `{"id":12,"name":"Ops_1","widgets":[{"id":1}]}`)
+			case "/controller/restui/dashboards/updateDashboard":
+				var dashboard map[string]interface{}
+				json.NewDecoder(r.Body).Decode(&dashboard)
+				if dashboard["widgets"] == nil {
+					t.Errorf("%s: rename dropped the widgets", test.name)
+				}
+				renamed, _ = dashboard["name"].(string)
+			default:
+				http.NotFound(w, r)
+			}
+		}))
+
+		name := "Ops"
+		_, err := client.Dashboard.ReplaceDashboard(5, &DashboardExport{Name: &name})
+		if (err != nil) != test.wantErr {
+			t.Errorf("%s: error %v, want error %t", test.name, err, test.wantErr)
+		}
+		if deleted != test.wantDeleted {
+			t.Errorf("%s: deleted %t, want %t", test.name, deleted, test.wantDeleted)
+		}
+		if renamed != test.wantRename {
+			t.Errorf("%s: renamed to %q, want %q", test.name, renamed, test.wantRename)
+		}
+	}
+}
@@ -0,0 +1,132 @@
+/*
+MIT License
+
+Copyright (c) 2026 Cisco Systems, Inc. and its affiliates
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+
+*/
+
+package appdrest
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// Consts for the database metric tree and common database KPIs
+const (
+	MetricTreeDatabases = "Databases"
+	MetricTreeKPI       = "KPI"
+
+	MetricDBCallsPerMinute        = "Calls per Minute"
+	MetricDBTimeSpentInExecutions = "Time Spent in Executions (s)"
+	MetricDBNumberOfConnections   = "Number of Connections"
+)
+
+// DatabaseCollector is a database monitored by a Database Agent
+type DatabaseCollector struct {
+	ID             int    `json:"id"`
+	Version        int    `json:"version"`
+	Name           string `json:"name"`
+	Type           string `json:"type"`
+	Hostname       string `json:"hostname"`
+	Port           int    `json:"port"`
+	Username       string `json:"username"`
+	DatabaseName   string `json:"databaseName"`
+	AgentName      string `json:"agentName"`
+	Enabled        bool   `json:"enabled"`
+	LoggingEnabled bool   `json:"loggingEnabled"`
+	UseSSL         bool   `json:"useSSL"`
+}
+
+// DatabaseMonitoringApplication is the application holding the metrics of all database collectors
+type DatabaseMonitoringApplication struct {
+	ID          int    `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Active      bool   `json:"active"`
+	AccountGUID string `json:"accountGuid"`
+}
+
+// DatabaseKPIMetricPath builds "Databases|collector|KPI|metric", collector may be "*"
+func DatabaseKPIMetricPath(collector string, metric string) MetricPath {
+	return NewMetricPath(MetricTreeDatabases, collector, MetricTreeKPI).Append(metric)
+}
+
+// DatabaseMonitoringService intermediates Database Monitoring requests
+type DatabaseMonitoringService service
+
+// GetDatabaseMonitoringApplication returns the application holding the database metrics
+func (s *DatabaseMonitoringService) GetDatabaseMonitoringApplication() (*DatabaseMonitoringApplication, error) {
+	apps, err := s.client.Application.GetAllInternalApplications()
+	if err != nil {
+		return nil, err
+	}
+	app := apps.DbMonApplication
+	if app.ID == 0 {
+		return nil, fmt.Errorf("database monitoring is not enabled on this controller")
+	}
+	return &DatabaseMonitoringApplication{
+		ID:          app.ID,
+		Name:        app.Name,
+		Description: app.Description,
+		Active:      app.Active,
+		AccountGUID: app.AccountGUID,
+	}, nil
+}
+
+// GetCollectors obtains all database collectors
+func (s *DatabaseMonitoringService) GetCollectors() ([]*DatabaseCollector, error) {
+
+	url := "controller/rest/databases/collectors"
+
+	var collectors []*DatabaseCollector
+	err := s.client.Rest("GET", url, &collectors, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return collectors, nil
+}
+
+// GetCollector obtains one database collector by ID
+func (s *DatabaseMonitoringService) GetCollector(collectorID int) (*DatabaseCollector, error) {
+
+	url := fmt.Sprintf("controller/rest/databases/collectors/%d", collectorID)
+
+	var collector *DatabaseCollector
+	err := s.client.Rest("GET", url, &collector, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return collector, nil
+}
+
+// GetKPIMetricData obtains a KPI metric of a database collector, use "*" for all collectors
+func (s *DatabaseMonitoringService) GetKPIMetricData(collector string, metric string, rollup bool, timeRangeType string, durationInMins int, startTime time.Time, endTime time.Time) ([]*MetricData, error) {
+	app, err := s.GetDatabaseMonitoringApplication()
+	if err != nil {
+		return nil, err
+	}
+	path := DatabaseKPIMetricPath(collector, metric)
+	return s.client.MetricData.GetMetricData(strconv.Itoa(app.ID), path.String(), rollup, timeRangeType, durationInMins, startTime, endTime)
+}
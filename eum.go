@@ -0,0 +1,159 @@
+/*
+MIT License
+
+Copyright (c) 2026 Cisco Systems, Inc. and its affiliates
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+
+*/
+
+package appdrest
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// EUMPageType is the kind of page of an EUM web application
+type EUMPageType string
+
+// Consts for the EUM page types, the value is the folder in the "End User Experience" metric tree
+const (
+	EUMBasePage    EUMPageType = "Base Pages"
+	EUMVirtualPage EUMPageType = "Virtual Pages"
+	EUMIFrame      EUMPageType = "Iframes"
+	EUMAjaxRequest EUMPageType = "AJAX Requests"
+)
+
+// EUMPageTypes lists all EUM page types
+var EUMPageTypes = []EUMPageType{EUMBasePage, EUMVirtualPage, EUMIFrame, EUMAjaxRequest}
+
+// Consts for the EUM metric tree and common EUM web metrics
+const (
+	MetricTreeEndUserExperience = "End User Experience"
+	MetricTreeEUMApp            = "App"
+
+	MetricEndUserResponseTime       = "End User Response Time (ms)"
+	MetricPageRequestsPerMinute     = "Page Requests per Minute"
+	MetricRequestsPerMinute         = "Requests per Minute"
+	MetricPageRenderTime            = "Page Render Time (ms)"
+	MetricFirstByteTime             = "First Byte Time (ms)"
+	MetricJavaScriptErrorsPerMinute = "JavaScript Errors per Minute"
+)
+
+// EUMPage is a base page, virtual page, iframe or AJAX request of an EUM web application
+type EUMPage struct {
+	ApplicationID int
+	Type          EUMPageType
+	Name          string
+}
+
+// EUMWebApplication is an EUM browser application, its ID takes the place of the application ID in metric queries
+type EUMWebApplication struct {
+	ID          int    `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Active      bool   `json:"active"`
+	AccountGUID string `json:"accountGuid"`
+	CreatedBy   string `json:"createdBy"`
+	CreatedOn   int64  `json:"createdOn"`
+}
+
+// MetricPath returns the path of a metric of the page
+func (p *EUMPage) MetricPath(metric string) MetricPath {
+	return EUMPageMetricPath(p.Type, p.Name, metric)
+}
+
+// EUMAppMetricPath builds "End User Experience|App|metric"
+func EUMAppMetricPath(metric string) MetricPath {
+	return NewMetricPath(MetricTreeEndUserExperience, MetricTreeEUMApp).Append(metric)
+}
+
+// EUMPageMetricPath builds "End User Experience|<page type>|page|metric", page may be "*"
+func EUMPageMetricPath(pageType EUMPageType, page string, metric string) MetricPath {
+	return NewMetricPath(MetricTreeEndUserExperience, string(pageType), page).Append(metric)
+}
+
+// EUMService intermediates requests for EUM web applications
+type EUMService service
+
+// GetWebApplications returns the EUM web applications of the controller
+func (s *EUMService) GetWebApplications() ([]*EUMWebApplication, error) {
+	apps, err := s.client.Application.GetAllInternalApplications()
+	if err != nil {
+		return nil, err
+	}
+
+	webApps := make([]*EUMWebApplication, 0, len(apps.EumWebApplications))
+	for _, app := range apps.EumWebApplications {
+		webApps = append(webApps, &EUMWebApplication{
+			ID:          app.ID,
+			Name:        app.Name,
+			Description: app.Description,
+			Active:      app.Active,
+			AccountGUID: app.AccountGUID,
+			CreatedBy:   app.CreatedBy,
+			CreatedOn:   app.CreatedOn,
+		})
+	}
+	return webApps, nil
+}
+
+// GetPages returns the pages of one type reported by an EUM web application
+func (s *EUMService) GetPages(appID int, pageType EUMPageType) ([]*EUMPage, error) {
+	folder := NewMetricPath(MetricTreeEndUserExperience, string(pageType)).String()
+	metrics, err := s.client.MetricData.GetMetricHierarchy(strconv.Itoa(appID), folder)
+	if err != nil {
+		return nil, fmt.Errorf("EUM pages: %v", err)
+	}
+
+	var pages []*EUMPage
+	for _, metric := range metrics {
+		if metric.Type == "folder" {
+			pages = append(pages, &EUMPage{ApplicationID: appID, Type: pageType, Name: metric.Name})
+		}
+	}
+	return pages, nil
+}
+
+// GetAllPages returns the pages of all types reported by an EUM web application
+func (s *EUMService) GetAllPages(appID int) ([]*EUMPage, error) {
+	var pages []*EUMPage
+	for _, pageType := range EUMPageTypes {
+		typed, err := s.GetPages(appID, pageType)
+		if err != nil {
+			return nil, err
+		}
+		pages = append(pages, typed...)
+	}
+	return pages, nil
+}
+
+// GetPageMetricData obtains one metric for all pages of a type with a single wildcard query
+func (s *EUMService) GetPageMetricData(appID int, pageType EUMPageType, metric string, rollup bool, timeRangeType string, durationInMins int, startTime time.Time, endTime time.Time) ([]*MetricData, error) {
+	path := EUMPageMetricPath(pageType, MetricPathWildcard, metric)
+	return s.client.MetricData.GetMetricData(strconv.Itoa(appID), path.String(), rollup, timeRangeType, durationInMins, startTime, endTime)
+}
+
+// GetAppMetricData obtains an application wide EUM metric
+func (s *EUMService) GetAppMetricData(appID int, metric string, rollup bool, timeRangeType string, durationInMins int, startTime time.Time, endTime time.Time) ([]*MetricData, error) {
+	path := EUMAppMetricPath(metric)
+	return s.client.MetricData.GetMetricData(strconv.Itoa(appID), path.String(), rollup, timeRangeType, durationInMins, startTime, endTime)
+}
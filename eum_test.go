@@ -0,0 +1,75 @@
+/*
+MIT License
+
+Copyright (c) 2026 Cisco Systems, Inc. and its affiliates
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+
+*/
+
+package appdrest
+
+import (
+	"net/http"
+	"testing"
+)
+
+// allApplicationTypes is a shortened getApplicationsAllTypes answer
+const allApplicationTypes = `{
+	"apmApplications": [{"id": 5, "name": "shop"}],
+	"eumWebApplications": [{"id": 12, "name": "shop-web", "description": "storefront", "active": true, "accountGuid": "guid-1", "createdBy": "admin", "createdOn": 1760000000000}],
+	"dbMonApplication": {"id": 3, "name": "Database Monitoring", "active": true, "accountGuid": "guid-1"},
+	"mobileAppContainers": [{"id": 14, "name": "shop-mobile", "active": true, "accountGuid": "guid-1", "applicationTypeInfo": {"eumMobileEnabled": true, "numberOfMobileApps": 2}}]
+}`
+
+func TestInternalApplicationGetters(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/controller/restui/applicationManagerUiBean/getApplicationsAllTypes" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+		}
+		w.Write([]byte(allApplicationTypes))
+	}))
+
+	webApps, err := client.EUM.GetWebApplications()
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantWeb := EUMWebApplication{ID: 12, Name: "shop-web", Description: "storefront", Active: true, AccountGUID: "guid-1", CreatedBy: "admin", CreatedOn: 1760000000000}
+	if len(webApps) != 1 || *webApps[0] != wantWeb {
+		t.Errorf("web applications %+v, want %+v", webApps, wantWeb)
+	}
+
+	containers, err := client.MobileApp.GetMobileAppContainers()
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantContainer := MobileAppContainer{ID: 14, Name: "shop-mobile", Active: true, AccountGUID: "guid-1", NumberOfMobileApps: 2}
+	if len(containers) != 1 || *containers[0] != wantContainer {
+		t.Errorf("mobile app containers %+v, want %+v", containers, wantContainer)
+	}
+
+	dbMon, err := client.DatabaseMonitoring.GetDatabaseMonitoringApplication()
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantDBMon := DatabaseMonitoringApplication{ID: 3, Name: "Database Monitoring", Active: true, AccountGUID: "guid-1"}
+	if *dbMon != wantDBMon {
+		t.Errorf("database monitoring application %+v, want %+v", *dbMon, wantDBMon)
+	}
+}
@@ -29,6 +29,7 @@ import (
 	"bytes"
 	"fmt"
 	"strconv"
+	"time"
 )
 
 type AffectsDetail struct {
@@ -133,6 +134,46 @@ type HealthRuleAggregationScopesStates struct {
 
 // DANGER ZONE END
 
+// HealthRuleViolation is one health rule violation event of an application
+// Added 2026 Cisco Systems, Inc.
+type HealthRuleViolation struct {
+	ID                       int    `json:"id"`
+	Name                     string `json:"name"`
+	Severity                 string `json:"severity"`       // WARNING or CRITICAL
+	IncidentStatus           string `json:"incidentStatus"` // OPEN, RESOLVED, CANCELLED, ...
+	TriggeredEntityType      string `json:"triggeredEntityType"`
+	StartTimeInMillis        int64  `json:"startTimeInMillis"`
+	EndTimeInMillis          int64  `json:"endTimeInMillis"`
+	DeepLinkURL              string `json:"deepLinkUrl"`
+	Description              string `json:"description"`
+	AffectedEntityDefinition struct {
+		EntityType string `json:"entityType"`
+		EntityID   int    `json:"entityId"`
+		Name       string `json:"name"`
+	} `json:"affectedEntityDefinition"`
+	TriggeredEntityDefinition struct {
+		EntityType string `json:"entityType"`
+		EntityID   int    `json:"entityId"`
+		Name       string `json:"name"`
+	} `json:"triggeredEntityDefinition"`
+}
+
+// Open reports whether the violation has not been resolved or cancelled yet
+func (v *HealthRuleViolation) Open() bool {
+	return v.IncidentStatus != "RESOLVED" && v.IncidentStatus != "CANCELLED"
+}
+
+// RawHealthRule is the full definition of a health rule as generic JSON,
+// unlike HealthRuleDetail it keeps schedules, evaluation scopes and every other field
+// Added 2026 Cisco Systems, Inc.
+type RawHealthRule map[string]interface{}
+
+// Name returns the name of the health rule
+func (r RawHealthRule) Name() string {
+	name, _ := r["name"].(string)
+	return name
+}
+
 // HealthRuleService intermediates Health Rules requests
 type HealthRuleService service
 
@@ -221,6 +262,55 @@ func (s *HealthRuleService) UpdateHealthRule(appID int, ruleID int, hr *HealthRu
 	return nil
 }
 
+// GetRawHealthRule obtains a single health rule as generic JSON
+// Added 2026 Cisco Systems, Inc.
+func (s *HealthRuleService) GetRawHealthRule(appID int, ruleID int) (RawHealthRule, error) {
+
+	url := "controller/alerting/rest/v1/applications/" + strconv.Itoa(appID) + "/health-rules/" + strconv.Itoa(ruleID)
+
+	var rule RawHealthRule
+	err := s.client.Rest("GET", url, &rule, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return rule, nil
+}
+
+// CreateRawHealthRule - create health rule for an application from generic JSON
+// Added 2026 Cisco Systems, Inc.
+func (s *HealthRuleService) CreateRawHealthRule(appID int, rule RawHealthRule) error {
+
+	url := "controller/alerting/rest/v1/applications/" + strconv.Itoa(appID) + "/health-rules"
+
+	err := s.client.RestInternal("POST", url, nil, rule)
+	if err != nil {
+		if fmt.Sprintf("%s", err) == "EOF" { // successful call returns EOF error -> empty body
+			return nil
+		}
+		return err
+	}
+
+	return nil
+}
+
+// UpdateRawHealthRule - update health rule of an application from generic JSON
+// Added 2026 Cisco Systems, Inc.
+func (s *HealthRuleService) UpdateRawHealthRule(appID int, ruleID int, rule RawHealthRule) error {
+
+	url := "controller/alerting/rest/v1/applications/" + strconv.Itoa(appID) + "/health-rules/" + strconv.Itoa(ruleID)
+
+	err := s.client.RestInternal("PUT", url, nil, rule)
+	if err != nil {
+		if fmt.Sprintf("%s", err) == "EOF" { // successful call returns EOF error -> empty body
+			return nil
+		}
+		return err
+	}
+
+	return nil
+}
+
 // DeleteHealthRule - create health rule for an application
 // TODO - test
 func (s *HealthRuleService) DeleteHealthRule(appID int, ruleID int) error {
@@ -239,6 +329,31 @@ func (s *HealthRuleService) DeleteHealthRule(appID int, ruleID int) error {
 	return nil
 }
 
+// GetHealthRuleViolations obtains the health rule violations of an application within a time range
+// Added 2026 Cisco Systems, Inc.
+func (s *HealthRuleService) GetHealthRuleViolations(appID int, timeRangeType string, durationInMins int, startTime time.Time, endTime time.Time) ([]*HealthRuleViolation, error) {
+
+	url := fmt.Sprintf("controller/rest/applications/%d/problems/healthrule-violations?output=json&time-range-type=%s", appID, timeRangeType)
+
+	if timeRangeType == TimeBEFORENOW || timeRangeType == TimeBEFORETIME || timeRangeType == TimeAFTERTIME {
+		url += fmt.Sprintf("&duration-in-mins=%d", durationInMins)
+	}
+	if timeRangeType == TimeAFTERTIME || timeRangeType == TimeBETWEENTIMES {
+		url += fmt.Sprintf("&start-time=%d", startTime.UnixMilli())
+	}
+	if timeRangeType == TimeBEFORETIME || timeRangeType == TimeBETWEENTIMES {
+		url += fmt.Sprintf("&end-time=%d", endTime.UnixMilli())
+	}
+
+	var violations []*HealthRuleViolation
+	err := s.client.Rest("GET", url, &violations, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return violations, nil
+}
+
 // DANGER ZONE
 // this is an UNPUBLISHED API call - it may change in the future
 // GET /controller/restui/healthRules/getHealthRuleCurrentEvaluationStatus/app/3503/healthRuleID/22196
@@ -0,0 +1,235 @@
+/*
+MIT License
+
+Copyright (c) 2026 Cisco Systems, Inc. and its affiliates
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+
+*/
+
+package hygiene
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"text/tabwriter"
+	"time"
+
+	appdrest "github.com/cisco-open/appd-client-go"
+)
+
+// Defaults for the business transaction analysis, the limits are the controller defaults
+const (
+	DefaultBTWindow           = 7 * 24 * time.Hour
+	DefaultTierBTLimit        = 50
+	DefaultApplicationBTLimit = 200
+	DefaultNearLimit          = 0.9
+)
+
+// BTOptions control the business transaction analysis
+type BTOptions struct {
+	Window           time.Duration // traffic is summed over this window
+	TierLimit        int           // BT limit per tier as configured on the agents
+	ApplicationLimit int           // BT limit of the application as configured on the controller
+	NearLimit        float64       // share of a limit from which low traffic BTs are suggested for exclusion
+	LowTraffic       int64         // BTs with at most this many calls in the window count as low traffic
+}
+
+func (o BTOptions) withDefaults() BTOptions {
+	if o.Window <= 0 {
+		o.Window = DefaultBTWindow
+	}
+	if o.TierLimit <= 0 {
+		o.TierLimit = DefaultTierBTLimit
+	}
+	if o.ApplicationLimit <= 0 {
+		o.ApplicationLimit = DefaultApplicationBTLimit
+	}
+	if o.NearLimit <= 0 || o.NearLimit > 1 {
+		o.NearLimit = DefaultNearLimit
+	}
+	return o
+}
+
+// BTUsage is the traffic of one BT in the window
+type BTUsage struct {
+	BusinessTransaction *appdrest.BusinessTransaction `json:"businessTransaction"`
+	Calls               int64                         `json:"calls"`
+}
+
+// TierBTUsage is the BT count and overflow traffic of one tier
+type TierBTUsage struct {
+	Tier          string  `json:"tier"`
+	BTs           int     `json:"bts"`
+	Limit         int     `json:"limit"`
+	Calls         int64   `json:"calls"`
+	OverflowCalls int64   `json:"overflowCalls"` // calls landing in "All Other Traffic"
+	OverflowShare float64 `json:"overflowShare"` // OverflowCalls / Calls
+	AtLimit       bool    `json:"atLimit"`
+}
+
+// ExclusionCandidate is a BT suggested for exclusion
+type ExclusionCandidate struct {
+	BTUsage
+	Reason string `json:"reason"`
+}
+
+// BTReport is the business transaction hygiene of one application
+type BTReport struct {
+	Application   string               `json:"application"`
+	ApplicationID int                  `json:"applicationId"`
+	GeneratedAt   time.Time            `json:"generatedAt"`
+	Window        time.Duration        `json:"window"`
+	BTs           int                  `json:"bts"`
+	Limit         int                  `json:"limit"`
+	AtLimit       bool                 `json:"atLimit"`
+	Calls         int64                `json:"calls"`
+	OverflowCalls int64                `json:"overflowCalls"`
+	Tiers         []TierBTUsage        `json:"tiers"`
+	ZeroTraffic   []BTUsage            `json:"zeroTraffic"`
+	Candidates    []ExclusionCandidate `json:"candidates"`
+}
+
+// CandidateIDs returns the IDs of the suggested exclusion candidates
+func (r *BTReport) CandidateIDs() []int {
+	ids := make([]int, len(r.Candidates))
+	for i, candidate := range r.Candidates {
+		ids[i] = candidate.BusinessTransaction.ID
+	}
+	return ids
+}
+
+// WriteText writes the report as text with aligned tables
+func (r *BTReport) WriteText(w io.Writer) error {
+	fmt.Fprintf(w, "%s: %d of %d BTs, %d calls in %s, %d in All Other Traffic\n", r.Application, r.BTs, r.Limit, r.Calls, r.Window, r.OverflowCalls)
+
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "TIER\tBTS\tLIMIT\tCALLS\tOVERFLOW CALLS\tOVERFLOW %")
+	for _, tier := range r.Tiers {
+		fmt.Fprintf(tw, "%s\t%d\t%d\t%d\t%d\t%.1f\n", tier.Tier, tier.BTs, tier.Limit, tier.Calls, tier.OverflowCalls, tier.OverflowShare*100)
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+
+	if len(r.Candidates) == 0 {
+		return nil
+	}
+	fmt.Fprintln(w, "exclusion candidates:")
+	tw = tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "TIER\tBUSINESS TRANSACTION\tID\tCALLS\tREASON")
+	for _, candidate := range r.Candidates {
+		bt := candidate.BusinessTransaction
+		fmt.Fprintf(tw, "%s\t%s\t%d\t%d\t%s\n", bt.TierName, bt.Name, bt.ID, candidate.Calls, candidate.Reason)
+	}
+	return tw.Flush()
+}
+
+// AnalyzeBusinessTransactions combines the registered BTs of an application with their calls
+// to find BTs without traffic, overflow traffic per tier and BT counts against the limits.
+// Exclusion candidates are BTs without traffic and, for tiers or applications near their limit,
+// BTs with at most opts.LowTraffic calls.
+func AnalyzeBusinessTransactions(client *appdrest.Client, appNameOrID string, opts BTOptions) (*BTReport, error) {
+	opts = opts.withDefaults()
+
+	app, err := client.Application.GetApplication(appNameOrID)
+	if err != nil {
+		return nil, fmt.Errorf("reading application %s: %v", appNameOrID, err)
+	}
+	bts, err := client.BusinessTransaction.GetBusinessTransactions(app.ID)
+	if err != nil {
+		return nil, fmt.Errorf("listing business transactions of %s: %v", app.Name, err)
+	}
+
+	path := appdrest.BusinessTransactionMetricPath(appdrest.MetricPathWildcard, appdrest.MetricPathWildcard, appdrest.MetricCallsPerMinute)
+	now := time.Now()
+	data, err := client.MetricData.GetMetricData(strconv.Itoa(app.ID), path.String(), true,
+		appdrest.TimeBEFORENOW, int(opts.Window/time.Minute), time.Time{}, now)
+	if err != nil {
+		return nil, fmt.Errorf("reading calls of %s: %v", app.Name, err)
+	}
+	calls := make(map[string]int64)
+	for _, metricData := range data {
+		entities := metricData.Path().Entities()
+		for _, value := range metricData.MetricValues {
+			calls[entities.Tier+"|"+entities.BusinessTransaction] += int64(value.Sum)
+		}
+	}
+
+	report := &BTReport{
+		Application:   app.Name,
+		ApplicationID: app.ID,
+		GeneratedAt:   now.UTC(),
+		Window:        opts.Window,
+		Limit:         opts.ApplicationLimit,
+	}
+
+	tiers := make(map[string]*TierBTUsage)
+	var usages []BTUsage
+	for _, bt := range bts {
+		tier := tiers[bt.TierName]
+		if tier == nil {
+			tier = &TierBTUsage{Tier: bt.TierName, Limit: opts.TierLimit}
+			tiers[bt.TierName] = tier
+		}
+		n := calls[bt.TierName+"|"+bt.Name]
+		tier.Calls += n
+		report.Calls += n
+		if bt.Name == appdrest.OverflowBusinessTransaction {
+			tier.OverflowCalls += n
+			report.OverflowCalls += n
+			continue
+		}
+		tier.BTs++
+		report.BTs++
+		usages = append(usages, BTUsage{BusinessTransaction: bt, Calls: n})
+	}
+
+	nearApplication := float64(report.BTs) >= opts.NearLimit*float64(opts.ApplicationLimit)
+	report.AtLimit = report.BTs >= opts.ApplicationLimit
+	for _, tier := range tiers {
+		tier.AtLimit = tier.BTs >= tier.Limit
+		if tier.Calls > 0 {
+			tier.OverflowShare = float64(tier.OverflowCalls) / float64(tier.Calls)
+		}
+		report.Tiers = append(report.Tiers, *tier)
+	}
+	sort.Slice(report.Tiers, func(i, j int) bool { return report.Tiers[i].Tier < report.Tiers[j].Tier })
+
+	sort.SliceStable(usages, func(i, j int) bool {
+		if usages[i].Calls != usages[j].Calls {
+			return usages[i].Calls < usages[j].Calls
+		}
+		return usages[i].BusinessTransaction.Name < usages[j].BusinessTransaction.Name
+	})
+	for _, usage := range usages {
+		tier := tiers[usage.BusinessTransaction.TierName]
+		nearTier := float64(tier.BTs) >= opts.NearLimit*float64(tier.Limit)
+		switch {
+		case usage.Calls == 0:
+			report.ZeroTraffic = append(report.ZeroTraffic, usage)
+			report.Candidates = append(report.Candidates, ExclusionCandidate{BTUsage: usage, Reason: fmt.Sprintf("no calls in %s", opts.Window)})
+		case usage.Calls <= opts.LowTraffic && (nearTier || nearApplication):
+			report.Candidates = append(report.Candidates, ExclusionCandidate{BTUsage: usage, Reason: fmt.Sprintf("%d calls in %s while near the BT limit", usage.Calls, opts.Window)})
+		}
+	}
+	return report, nil
+}
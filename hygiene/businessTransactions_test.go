@@ -0,0 +1,129 @@
+/*
+MIT License
+
+Copyright (c) 2026 Cisco Systems, Inc. and its affiliates
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+
+*/
+
+package hygiene
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+
+	appdrest "github.com/cisco-open/appd-client-go"
+	"github.com/cisco-open/appd-client-go/internal/apptest"
+)
+
+func newTestClient(t *testing.T, handler http.Handler) *appdrest.Client {
+	t.Helper()
+	host, port := apptest.Server(t, handler)
+	client, err := appdrest.NewClient("http", host, port, "user", "secret", "customer1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return client
+}
+
+// btController serves application 5 "shop" with the BTs and their calls in the window
+type btController struct {
+	t     *testing.T
+	bts   []*appdrest.BusinessTransaction
+	calls map[string]int // by "tier|bt"
+}
+
+func (c *btController) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch r.URL.Path {
+	case "/controller/rest/applications/shop":
+		fmt.Fprint(w, `[{"id":5,"name":"shop"}]`)
+	case "/controller/rest/applications/5/business-transactions":
+		json.NewEncoder(w).Encode(c.bts)
+	case "/controller/rest/applications/5/metric-data":
+		if r.URL.Query().Get("duration-in-mins") != "10080" {
+			c.t.Errorf("unexpected window %s", r.URL.RawQuery)
+		}
+		var metrics []appdrest.MetricData
+		for _, bt := range c.bts {
+			path := appdrest.BusinessTransactionMetricPath(bt.TierName, bt.Name, appdrest.MetricCallsPerMinute)
+			metrics = append(metrics, appdrest.MetricData{MetricPath: path.String(), MetricValues: []appdrest.MetricValue{{Sum: c.calls[bt.TierName+"|"+bt.Name]}}})
+		}
+		json.NewEncoder(w).Encode(metrics)
+	default:
+		c.t.Errorf("unexpected request %s %s", r.Method, r.URL)
+		http.NotFound(w, r)
+	}
+}
+
+func TestAnalyzeBusinessTransactions(t *testing.T) {
+	controller := &btController{t: t, calls: map[string]int{
+		"web|/a": 0, "web|/b": 5, "web|/c|v2": 100, "web|/d": 8, "web|" + appdrest.OverflowBusinessTransaction: 50,
+		"batch|job1": 0, "batch|job2": 3,
+	}}
+	for i, name := range []string{"/a", "/b", "/c|v2", "/d", appdrest.OverflowBusinessTransaction} {
+		controller.bts = append(controller.bts, &appdrest.BusinessTransaction{ID: 10 + i, TierName: "web", Name: name})
+	}
+	for i, name := range []string{"job1", "job2"} {
+		controller.bts = append(controller.bts, &appdrest.BusinessTransaction{ID: 20 + i, TierName: "batch", Name: name})
+	}
+	client := newTestClient(t, controller)
+
+	tests := []struct {
+		name       string
+		opts       BTOptions
+		candidates []int
+	}{
+		// web is near its limit of 5 with 4 BTs, batch is not
+		{"tier near limit", BTOptions{TierLimit: 5, NearLimit: 0.8, LowTraffic: 10}, []int{10, 20, 11, 13}},
+		// 6 BTs are near an application limit of 7, low traffic BTs of every tier are candidates
+		{"application near limit", BTOptions{TierLimit: 5, ApplicationLimit: 7, NearLimit: 0.8, LowTraffic: 10}, []int{10, 20, 21, 11, 13}},
+		{"far from limits", BTOptions{LowTraffic: 10}, []int{10, 20}},
+	}
+	for _, test := range tests {
+		report, err := AnalyzeBusinessTransactions(client, "shop", test.opts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if ids := report.CandidateIDs(); !reflect.DeepEqual(ids, test.candidates) {
+			t.Errorf("%s: candidates %v, want %v", test.name, ids, test.candidates)
+		}
+		if len(report.ZeroTraffic) != 2 || report.ZeroTraffic[0].BusinessTransaction.ID != 10 || report.ZeroTraffic[1].BusinessTransaction.ID != 20 {
+			t.Errorf("%s: zero traffic %+v", test.name, report.ZeroTraffic)
+		}
+	}
+
+	report, err := AnalyzeBusinessTransactions(client, "shop", BTOptions{TierLimit: 4})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.BTs != 6 || report.Calls != 166 || report.OverflowCalls != 50 || report.Limit != DefaultApplicationBTLimit || report.AtLimit {
+		t.Errorf("report %+v", report)
+	}
+	wantTiers := []TierBTUsage{
+		{Tier: "batch", BTs: 2, Limit: 4, Calls: 3},
+		{Tier: "web", BTs: 4, Limit: 4, Calls: 163, OverflowCalls: 50, OverflowShare: 50.0 / 163, AtLimit: true},
+	}
+	if !reflect.DeepEqual(report.Tiers, wantTiers) {
+		t.Errorf("tiers %+v, want %+v", report.Tiers, wantTiers)
+	}
+}
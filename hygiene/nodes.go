@@ -0,0 +1,251 @@
+/*
+MIT License
+
+Copyright (c) 2026 Cisco Systems, Inc. and its affiliates
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+
+*/
+
+// Package hygiene finds stale and unused entities of AppDynamics applications
+// so they can be cleaned up before they count against licenses and limits.
+package hygiene
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"text/tabwriter"
+	"time"
+
+	appdrest "github.com/cisco-open/appd-client-go"
+)
+
+// Defaults for stale node detection
+const (
+	DefaultStaleAfter = 24 * time.Hour
+	DefaultLookback   = 7 * 24 * time.Hour
+)
+
+// NodeOptions control stale node detection
+type NodeOptions struct {
+	Applications []string      // application names or IDs, empty for all APM applications
+	StaleAfter   time.Duration // nodes without availability reports for this long are stale
+	Lookback     time.Duration // how far back the last report is searched, at least StaleAfter
+}
+
+func (o NodeOptions) withDefaults() NodeOptions {
+	if o.StaleAfter <= 0 {
+		o.StaleAfter = DefaultStaleAfter
+	}
+	if o.Lookback < o.StaleAfter {
+		o.Lookback = DefaultLookback
+		if o.Lookback < o.StaleAfter {
+			o.Lookback = o.StaleAfter
+		}
+	}
+	return o
+}
+
+// StaleNode is a node that has not reported availability recently
+type StaleNode struct {
+	Application   string         `json:"application"`
+	ApplicationID int            `json:"applicationId"`
+	Node          *appdrest.Node `json:"node"`
+	LastSeen      time.Time      `json:"lastSeen"` // zero when there was no report within the lookback
+}
+
+// NodeReport lists the stale nodes of all inspected applications
+type NodeReport struct {
+	GeneratedAt  time.Time     `json:"generatedAt"`
+	StaleAfter   time.Duration `json:"staleAfter"`
+	Lookback     time.Duration `json:"lookback"`
+	Applications int           `json:"applications"`
+	Nodes        int           `json:"nodes"`
+	Stale        []StaleNode   `json:"stale"`
+}
+
+// NodeIDs returns the IDs of the stale nodes
+func (r *NodeReport) NodeIDs() []int {
+	ids := make([]int, len(r.Stale))
+	for i, stale := range r.Stale {
+		ids[i] = stale.Node.ID
+	}
+	return ids
+}
+
+// WriteText writes the report as a table
+func (r *NodeReport) WriteText(w io.Writer) error {
+	fmt.Fprintf(w, "%d of %d nodes in %d applications without availability for %s\n", len(r.Stale), r.Nodes, r.Applications, r.StaleAfter)
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "APPLICATION\tTIER\tNODE\tID\tLAST SEEN")
+	for _, stale := range r.Stale {
+		lastSeen := "never"
+		if !stale.LastSeen.IsZero() {
+			lastSeen = stale.LastSeen.Format(time.RFC3339)
+		} else if r.Lookback > 0 {
+			lastSeen = fmt.Sprintf("more than %s ago", r.Lookback)
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%d\t%s\n", stale.Application, stale.Node.TierName, stale.Node.Name, stale.Node.ID, lastSeen)
+	}
+	return tw.Flush()
+}
+
+// FindStaleNodes reports nodes without agent availability reports for opts.StaleAfter.
+// App agent availability is used for nodes with an app agent, machine agent availability otherwise.
+func FindStaleNodes(client *appdrest.Client, opts NodeOptions) (*NodeReport, error) {
+	opts = opts.withDefaults()
+	now := time.Now()
+	report := &NodeReport{GeneratedAt: now.UTC(), StaleAfter: opts.StaleAfter, Lookback: opts.Lookback}
+
+	apps := opts.Applications
+	if len(apps) == 0 {
+		all, err := client.Application.GetApplications()
+		if err != nil {
+			return nil, err
+		}
+		for _, app := range all {
+			apps = append(apps, strconv.Itoa(app.ID))
+		}
+	}
+
+	for _, appNameOrID := range apps {
+		app, err := client.Application.GetApplication(appNameOrID)
+		if err != nil {
+			return nil, fmt.Errorf("reading application %s: %v", appNameOrID, err)
+		}
+		nodes, err := client.Node.GetNodes(strconv.Itoa(app.ID))
+		if err != nil {
+			return nil, fmt.Errorf("listing nodes of %s: %v", app.Name, err)
+		}
+		lastSeen, err := lastAvailability(client, app.ID, now, opts.Lookback)
+		if err != nil {
+			return nil, fmt.Errorf("reading availability of %s: %v", app.Name, err)
+		}
+
+		report.Applications++
+		report.Nodes += len(nodes)
+		for _, node := range nodes {
+			metric := appdrest.MetricAgentAvailability
+			if !node.AppAgentPresent && node.MachineAgentPresent {
+				metric = appdrest.MetricMachineAvailability
+			}
+			seen := lastSeen[availabilityKey(node.TierName, node.Name, metric)]
+			if seen.IsZero() || now.Sub(seen) > opts.StaleAfter {
+				report.Stale = append(report.Stale, StaleNode{Application: app.Name, ApplicationID: app.ID, Node: node, LastSeen: seen})
+			}
+		}
+	}
+
+	sort.SliceStable(report.Stale, func(i, j int) bool {
+		a, b := report.Stale[i], report.Stale[j]
+		if a.Application != b.Application {
+			return a.Application < b.Application
+		}
+		if a.Node.TierName != b.Node.TierName {
+			return a.Node.TierName < b.Node.TierName
+		}
+		return a.Node.Name < b.Node.Name
+	})
+	return report, nil
+}
+
+func availabilityKey(tier string, node string, metric string) string {
+	return tier + "|" + node + "|" + metric
+}
+
+// lastAvailability returns the start of the last bucket with a positive availability per node and metric
+func lastAvailability(client *appdrest.Client, appID int, now time.Time, lookback time.Duration) (map[string]time.Time, error) {
+	lastSeen := make(map[string]time.Time)
+	for _, metric := range []string{appdrest.MetricAgentAvailability, appdrest.MetricMachineAvailability} {
+		path := appdrest.AgentAvailabilityMetricPath(appdrest.MetricPathWildcard, appdrest.MetricPathWildcard)
+		if metric == appdrest.MetricMachineAvailability {
+			path = appdrest.MachineAvailabilityMetricPath(appdrest.MetricPathWildcard, appdrest.MetricPathWildcard)
+		}
+		data, err := client.MetricData.GetMetricData(strconv.Itoa(appID), path.String(), false,
+			appdrest.TimeBEFORENOW, int(lookback/time.Minute), time.Time{}, now)
+		if err != nil {
+			return nil, err
+		}
+		for _, metricData := range data {
+			entities := metricData.Path().Entities()
+			key := availabilityKey(entities.Tier, entities.Node, metric)
+			for _, value := range metricData.MetricValues {
+				if value.Value <= 0 && value.Sum <= 0 {
+					continue
+				}
+				t := time.UnixMilli(value.StartTimeInMillis)
+				if t.After(lastSeen[key]) {
+					lastSeen[key] = t
+				}
+			}
+		}
+	}
+	return lastSeen, nil
+}
+
+// MarkOptions control marking nodes historical
+type MarkOptions struct {
+	BatchSize int  // nodes per request, at most appdrest.MaxHistoricalNodesPerCall
+	DryRun    bool // only report the batches
+}
+
+// MarkResult lists the nodes marked historical and the batches that failed
+type MarkResult struct {
+	DryRun  bool     `json:"dryRun"`
+	Marked  []int    `json:"marked"`
+	Failed  []int    `json:"failed"`
+	Batches [][]int  `json:"batches"`
+	Errors  []string `json:"errors,omitempty"`
+}
+
+// MarkHistorical marks the nodes in batches, a failing batch does not stop the following ones.
+// The returned error is set when any batch failed.
+func MarkHistorical(client *appdrest.Client, nodeIDs []int, opts MarkOptions) (*MarkResult, error) {
+	size := opts.BatchSize
+	if size <= 0 || size > appdrest.MaxHistoricalNodesPerCall {
+		size = appdrest.MaxHistoricalNodesPerCall
+	}
+
+	result := &MarkResult{DryRun: opts.DryRun}
+	for start := 0; start < len(nodeIDs); start += size {
+		end := start + size
+		if end > len(nodeIDs) {
+			end = len(nodeIDs)
+		}
+		batch := nodeIDs[start:end]
+		result.Batches = append(result.Batches, batch)
+		if opts.DryRun {
+			continue
+		}
+		err := client.Node.MarkNodesHistorical(batch)
+		if err != nil {
+			result.Failed = append(result.Failed, batch...)
+			result.Errors = append(result.Errors, err.Error())
+			continue
+		}
+		result.Marked = append(result.Marked, batch...)
+	}
+
+	if len(result.Errors) > 0 {
+		return result, fmt.Errorf("%d of %d batches failed: %v", len(result.Errors), len(result.Batches), result.Errors[0])
+	}
+	return result, nil
+}
@@ -0,0 +1,173 @@
+/*
+MIT License
+
+Copyright (c) 2026 Cisco Systems, Inc. and its affiliates
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+
+*/
+
+package hygiene
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	appdrest "github.com/cisco-open/appd-client-go"
+)
+
+// availability is a node that reported availability at the given times before the request
+type availability struct {
+	tier    string
+	node    string
+	machine bool            // machine agent availability instead of app agent availability
+	ago     []time.Duration // buckets with a positive value
+	zero    []time.Duration // buckets reported as unavailable
+}
+
+func TestFindStaleNodes(t *testing.T) {
+	nodes := []*appdrest.Node{
+		{ID: 1, TierName: "web", Name: "fresh", AppAgentPresent: true, MachineAgentPresent: true},
+		{ID: 2, TierName: "web", Name: "stale", AppAgentPresent: true},
+		{ID: 3, TierName: "web", Name: "down", AppAgentPresent: true},
+		{ID: 4, TierName: "batch", Name: "machine-only", MachineAgentPresent: true},
+		{ID: 5, TierName: "batch", Name: "app-agent-gone", AppAgentPresent: true, MachineAgentPresent: true},
+		{ID: 6, TierName: "web", Name: "never", AppAgentPresent: true},
+	}
+	reports := []availability{
+		{tier: "web", node: "fresh", ago: []time.Duration{2 * time.Hour, time.Hour}},
+		{tier: "web", node: "stale", ago: []time.Duration{30 * time.Hour}},
+		{tier: "web", node: "down", ago: []time.Duration{40 * time.Hour}, zero: []time.Duration{time.Hour}},
+		{tier: "batch", node: "machine-only", machine: true, ago: []time.Duration{time.Hour}},
+		{tier: "batch", node: "app-agent-gone", machine: true, ago: []time.Duration{time.Hour}},
+		{tier: "batch", node: "app-agent-gone", ago: []time.Duration{50 * time.Hour}},
+	}
+
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/controller/rest/applications/shop":
+			fmt.Fprint(w, `[{"id":5,"name":"shop"}]`)
+		case "/controller/rest/applications/5/nodes":
+			json.NewEncoder(w).Encode(nodes)
+		case "/controller/rest/applications/5/metric-data":
+			if r.URL.Query().Get("duration-in-mins") != "10080" {
+				t.Errorf("unexpected lookback %s", r.URL.RawQuery)
+			}
+			machine := r.URL.Query().Get("metric-path") == appdrest.MachineAvailabilityMetricPath("*", "*").String()
+			var metrics []appdrest.MetricData
+			for _, report := range reports {
+				if report.machine != machine {
+					continue
+				}
+				path := appdrest.AgentAvailabilityMetricPath(report.tier, report.node)
+				if machine {
+					path = appdrest.MachineAvailabilityMetricPath(report.tier, report.node)
+				}
+				metric := appdrest.MetricData{MetricPath: path.String()}
+				for _, ago := range report.ago {
+					metric.MetricValues = append(metric.MetricValues, appdrest.MetricValue{StartTimeInMillis: time.Now().Add(-ago).UnixMilli(), Value: 1, Sum: 1})
+				}
+				for _, ago := range report.zero {
+					metric.MetricValues = append(metric.MetricValues, appdrest.MetricValue{StartTimeInMillis: time.Now().Add(-ago).UnixMilli()})
+				}
+				metrics = append(metrics, metric)
+			}
+			json.NewEncoder(w).Encode(metrics)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+			http.NotFound(w, r)
+		}
+	}))
+
+	report, err := FindStaleNodes(client, NodeOptions{Applications: []string{"shop"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// sorted by tier and node, app agent availability counts for nodes with an app agent
+	if ids := report.NodeIDs(); !reflect.DeepEqual(ids, []int{5, 3, 6, 2}) {
+		t.Errorf("stale nodes %v, want [5 3 6 2]", ids)
+	}
+	if report.Applications != 1 || report.Nodes != 6 || report.StaleAfter != DefaultStaleAfter || report.Lookback != DefaultLookback {
+		t.Errorf("report %+v", report)
+	}
+	for _, stale := range report.Stale {
+		wantSeen := map[int]time.Duration{5: 50 * time.Hour, 3: 40 * time.Hour, 2: 30 * time.Hour}[stale.Node.ID]
+		if seen := time.Since(stale.LastSeen); (wantSeen == 0) != stale.LastSeen.IsZero() || (wantSeen != 0 && (seen < wantSeen || seen > wantSeen+time.Minute)) {
+			t.Errorf("node %s last seen %v", stale.Node.Name, stale.LastSeen)
+		}
+	}
+
+	report, err = FindStaleNodes(client, NodeOptions{Applications: []string{"shop"}, StaleAfter: 45 * time.Hour})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ids := report.NodeIDs(); !reflect.DeepEqual(ids, []int{5, 6}) {
+		t.Errorf("nodes stale for 45h %v, want [5 6]", ids)
+	}
+}
+
+func TestNodeOptionsDefaults(t *testing.T) {
+	tests := []struct {
+		opts     NodeOptions
+		stale    time.Duration
+		lookback time.Duration
+	}{
+		{NodeOptions{}, DefaultStaleAfter, DefaultLookback},
+		{NodeOptions{StaleAfter: time.Hour, Lookback: 2 * time.Hour}, time.Hour, 2 * time.Hour},
+		{NodeOptions{StaleAfter: 48 * time.Hour, Lookback: time.Hour}, 48 * time.Hour, DefaultLookback},
+		{NodeOptions{StaleAfter: 30 * 24 * time.Hour}, 30 * 24 * time.Hour, 30 * 24 * time.Hour},
+	}
+	for _, test := range tests {
+		got := test.opts.withDefaults()
+		if got.StaleAfter != test.stale || got.Lookback != test.lookback {
+			t.Errorf("%+v with defaults: stale after %v, lookback %v, want %v, %v", test.opts, got.StaleAfter, got.Lookback, test.stale, test.lookback)
+		}
+	}
+}
+
+func TestMarkHistorical(t *testing.T) {
+	var batches [][]int
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var ids []int
+		for _, id := range strings.Split(r.URL.Query().Get("application-component-node-ids"), ",") {
+			n, _ := strconv.Atoi(id)
+			ids = append(ids, n)
+		}
+		batches = append(batches, ids)
+		if len(batches) == 2 {
+			http.Error(w, "boom", http.StatusInternalServerError)
+		}
+	}))
+
+	result, err := MarkHistorical(client, []int{1, 2, 3, 4, 5}, MarkOptions{BatchSize: 2, DryRun: true})
+	if err != nil || len(batches) != 0 || !reflect.DeepEqual(result.Batches, [][]int{{1, 2}, {3, 4}, {5}}) {
+		t.Errorf("dry run %+v, %v, sent %v", result, err, batches)
+	}
+
+	result, err = MarkHistorical(client, []int{1, 2, 3, 4, 5}, MarkOptions{BatchSize: 2})
+	if err == nil || !reflect.DeepEqual(result.Marked, []int{1, 2, 5}) || !reflect.DeepEqual(result.Failed, []int{3, 4}) || len(batches) != 3 {
+		t.Errorf("marked %+v, %v, sent %v", result, err, batches)
+	}
+}
@@ -0,0 +1,390 @@
+/*
+MIT License
+
+Copyright (c) 2026 Cisco Systems, Inc. and its affiliates
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+
+*/
+
+// Package impact reports how a backend performed and which tiers and business transactions
+// depend on it, across all applications calling the same backend.
+package impact
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	appdrest "github.com/cisco-open/appd-client-go"
+	"github.com/cisco-open/appd-client-go/series"
+)
+
+// DefaultDuration is the time range analysed when Options.TimeSpec is empty
+const DefaultDuration = time.Hour
+
+// Options control the backend impact analysis
+type Options struct {
+	Applications []string          // application names or IDs, empty for all
+	TimeSpec     appdrest.TimeSpec // time range, the last DefaultDuration when empty
+	TrendStep    time.Duration     // resampling step of the latency trend, 0 keeps the metric resolution
+}
+
+func (o Options) withDefaults() Options {
+	if o.TimeSpec.Type == "" {
+		o.TimeSpec = appdrest.BeforeNow(DefaultDuration)
+	}
+	return o
+}
+
+// Caller is a tier or remote application calling the backend, with the flow map statistics of the call
+type Caller struct {
+	Application string                    `json:"application"`
+	Name        string                    `json:"name"`
+	Type        appdrest.TopologyNodeType `json:"type"`
+	appdrest.TopologyStats
+}
+
+// CallerBT is a business transaction with exit calls to the backend
+type CallerBT struct {
+	Application    string  `json:"application"`
+	Tier           string  `json:"tier"`
+	Name           string  `json:"name"`
+	CallsPerMinute float64 `json:"callsPerMinute"`
+}
+
+// Usage is the backend as seen by one application
+type Usage struct {
+	Application          string            `json:"application"`
+	ApplicationID        int               `json:"applicationId"`
+	Backend              *appdrest.Backend `json:"backend"`
+	CallsPerMinute       float64           `json:"callsPerMinute"`
+	AverageResponseTime  float64           `json:"averageResponseTime"` // ms, weighted by calls
+	ErrorsPerMinute      float64           `json:"errorsPerMinute"`
+	ErrorRate            float64           `json:"errorRate"`
+	LatencyTrend         *series.Series    `json:"latencyTrend"`
+	Callers              []Caller          `json:"callers"`
+	BusinessTransactions []CallerBT        `json:"businessTransactions"`
+	Notes                []string          `json:"notes,omitempty"` // limits of the analysis, e.g. callers taken from a tier
+
+	art, calls *series.Series // metric resolution, for the call weighted report trend
+}
+
+// Report is the impact of a backend across applications
+type Report struct {
+	GeneratedAt         time.Time         `json:"generatedAt"`
+	TimeSpec            appdrest.TimeSpec `json:"timeSpec"`
+	Usages              []*Usage          `json:"usages"`
+	CallsPerMinute      float64           `json:"callsPerMinute"`
+	AverageResponseTime float64           `json:"averageResponseTime"`
+	ErrorsPerMinute     float64           `json:"errorsPerMinute"`
+	ErrorRate           float64           `json:"errorRate"`
+	LatencyChange       series.Comparison `json:"latencyChange"` // call weighted response time of the second half of the range against the first half
+	Failures            []string          `json:"failures,omitempty"`
+}
+
+// Callers returns the callers of all applications, busiest first
+func (r *Report) Callers() []Caller {
+	var callers []Caller
+	for _, usage := range r.Usages {
+		callers = append(callers, usage.Callers...)
+	}
+	sort.SliceStable(callers, func(i, j int) bool { return callers[i].CallsPerMinute > callers[j].CallsPerMinute })
+	return callers
+}
+
+// WriteText writes the report as text with aligned tables
+func (r *Report) WriteText(w io.Writer) error {
+	fmt.Fprintf(w, "%.1f calls/min, %.0f ms, %.1f errors/min (%.2f%%), latency change %+.1f ms\n",
+		r.CallsPerMinute, r.AverageResponseTime, r.ErrorsPerMinute, r.ErrorRate*100, r.LatencyChange.Delta)
+
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "APPLICATION\tBACKEND\tCALLS/MIN\tART (MS)\tERRORS/MIN\tERROR %")
+	for _, usage := range r.Usages {
+		fmt.Fprintf(tw, "%s\t%s\t%.1f\t%.0f\t%.1f\t%.2f\n", usage.Application, usage.Backend.Name,
+			usage.CallsPerMinute, usage.AverageResponseTime, usage.ErrorsPerMinute, usage.ErrorRate*100)
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+
+	tw = tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "APPLICATION\tCALLER\tTYPE\tCALLS/MIN\tART (MS)\tERRORS/MIN")
+	for _, caller := range r.Callers() {
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%.1f\t%.0f\t%.1f\n", caller.Application, caller.Name, caller.Type,
+			caller.CallsPerMinute, caller.AverageResponseTime, caller.ErrorsPerMinute)
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+
+	for _, usage := range r.Usages {
+		for _, note := range usage.Notes {
+			fmt.Fprintf(w, "note: %s %s: %s\n", usage.Application, usage.Backend.Name, note)
+		}
+	}
+	for _, failure := range r.Failures {
+		fmt.Fprintf(w, "failed: %s\n", failure)
+	}
+	return nil
+}
+
+// Analyze finds the backends matching the filter in every application, e.g.
+// appdrest.BackendFilter{Properties: map[string]string{"HOST": "db1", "PORT": "5432"}},
+// and reports their calls, response time, errors and callers.
+// Callers come from the flow map, business transactions from their external call metrics.
+// A backend resolved to a tier has no node of its own on the flow map, its callers are the callers of that tier,
+// which is noted in Usage.Notes.
+// Applications that fail to load are listed in Report.Failures.
+func Analyze(client *appdrest.Client, filter appdrest.BackendFilter, opts Options) (*Report, error) {
+	opts = opts.withDefaults()
+	report := &Report{GeneratedAt: time.Now().UTC(), TimeSpec: opts.TimeSpec}
+
+	apps := opts.Applications
+	if len(apps) == 0 {
+		all, err := client.Application.GetApplications()
+		if err != nil {
+			return nil, err
+		}
+		for _, app := range all {
+			apps = append(apps, strconv.Itoa(app.ID))
+		}
+	}
+
+	for _, appNameOrID := range apps {
+		usages, err := analyzeApplication(client, appNameOrID, filter, opts)
+		if err != nil {
+			report.Failures = append(report.Failures, fmt.Sprintf("%s: %v", appNameOrID, err))
+			continue
+		}
+		report.Usages = append(report.Usages, usages...)
+	}
+	sort.SliceStable(report.Usages, func(i, j int) bool { return report.Usages[i].CallsPerMinute > report.Usages[j].CallsPerMinute })
+
+	var arts, calls []*series.Series
+	for _, usage := range report.Usages {
+		report.CallsPerMinute += usage.CallsPerMinute
+		report.ErrorsPerMinute += usage.ErrorsPerMinute
+		report.AverageResponseTime += usage.AverageResponseTime * usage.CallsPerMinute
+		arts = append(arts, usage.art)
+		calls = append(calls, usage.calls)
+	}
+	if report.CallsPerMinute > 0 {
+		report.AverageResponseTime /= report.CallsPerMinute
+		report.ErrorRate = report.ErrorsPerMinute / report.CallsPerMinute
+	}
+	report.LatencyChange = halfOverHalf(weightedMerge(arts, calls), series.Merge("", calls, series.Sum))
+	return report, nil
+}
+
+func analyzeApplication(client *appdrest.Client, appNameOrID string, filter appdrest.BackendFilter, opts Options) ([]*Usage, error) {
+	app, err := client.Application.GetApplication(appNameOrID)
+	if err != nil {
+		return nil, err
+	}
+	appID := strconv.Itoa(app.ID)
+	backends, err := client.Backend.SelectBackends(appID, filter)
+	if err != nil || len(backends) == 0 {
+		return nil, err
+	}
+
+	ts := opts.TimeSpec
+	topology, err := client.Topology.GetApplicationTopology(app.ID, ts.Type, ts.DurationInMins, ts.StartTime, ts.EndTime)
+	if err != nil {
+		return nil, err
+	}
+	bts, err := exitCallBTs(client, app, ts)
+	if err != nil {
+		return nil, err
+	}
+
+	var usages []*Usage
+	for _, backend := range backends {
+		usage := &Usage{Application: app.Name, ApplicationID: app.ID, Backend: backend}
+
+		values := make(map[string]*series.Series)
+		for metric, agg := range map[string]series.Aggregation{
+			appdrest.MetricCallsPerMinute:      series.Sum,
+			appdrest.MetricAverageResponseTime: series.Avg,
+			appdrest.MetricErrorsPerMinute:     series.Sum,
+		} {
+			path := appdrest.BackendMetricPath(backend.Name, metric)
+			data, err := client.MetricData.GetMetricData(appID, path.String(), false, ts.Type, ts.DurationInMins, ts.StartTime, ts.EndTime)
+			if err != nil {
+				return nil, err
+			}
+			values[metric] = series.Merge(path.String(), series.FromMetricData(data), agg)
+		}
+		calls, art, errs := values[appdrest.MetricCallsPerMinute], values[appdrest.MetricAverageResponseTime], values[appdrest.MetricErrorsPerMinute]
+
+		usage.CallsPerMinute = zeroNaN(calls.Aggregate(series.Avg))
+		usage.ErrorsPerMinute = zeroNaN(errs.Aggregate(series.Avg))
+		usage.AverageResponseTime = weightedAverage(art, calls)
+		if usage.CallsPerMinute > 0 {
+			usage.ErrorRate = usage.ErrorsPerMinute / usage.CallsPerMinute
+		}
+		usage.LatencyTrend = art
+		if opts.TrendStep > 0 {
+			usage.LatencyTrend = art.Resample(opts.TrendStep, series.Avg)
+		}
+		usage.art, usage.calls = art, calls
+
+		node := fmt.Sprintf("%s:%d", appdrest.TopologyBackend, backend.ID)
+		if topology.Node(node) == nil && backend.Resolved() {
+			node = fmt.Sprintf("%s:%d", appdrest.TopologyTier, backend.TierID)
+			usage.Notes = append(usage.Notes, "resolved to a tier, callers are all callers of that tier")
+		}
+		if topology.Node(node) == nil {
+			usage.Notes = append(usage.Notes, "not on the flow map of the time range, no callers")
+		}
+		for _, edge := range topology.Incoming(node) {
+			source := topology.Node(edge.Source)
+			if source == nil {
+				continue
+			}
+			usage.Callers = append(usage.Callers, Caller{Application: app.Name, Name: source.Name, Type: source.Type, TopologyStats: edge.TopologyStats})
+		}
+
+		suffix := " - " + backend.Name
+		for _, bt := range bts {
+			if strings.HasSuffix(bt.exitCall, suffix) {
+				usage.BusinessTransactions = append(usage.BusinessTransactions, bt.CallerBT)
+			}
+		}
+		usages = append(usages, usage)
+	}
+	return usages, nil
+}
+
+type exitCallBT struct {
+	CallerBT
+	exitCall string // e.g. "Call-JDBC to Discovered backend call - ORDERS-MySQL"
+}
+
+// exitCallBTs reads the exit call rates of all BTs of an application with one wildcard query
+func exitCallBTs(client *appdrest.Client, app *appdrest.Application, ts appdrest.TimeSpec) ([]exitCallBT, error) {
+	path := appdrest.BusinessTransactionMetricPath(appdrest.MetricPathWildcard, appdrest.MetricPathWildcard, "External Calls").
+		Append(appdrest.MetricPathWildcard).Append(appdrest.MetricCallsPerMinute)
+	data, err := client.MetricData.GetMetricData(strconv.Itoa(app.ID), path.String(), true, ts.Type, ts.DurationInMins, ts.StartTime, ts.EndTime)
+	if err != nil {
+		return nil, err
+	}
+
+	var bts []exitCallBT
+	for _, metricData := range data {
+		entities := metricData.Path().Entities()
+		segments := appdrest.ParseMetricPath(entities.Metric).Segments
+		if len(segments) != 3 || len(metricData.MetricValues) == 0 {
+			continue
+		}
+		bts = append(bts, exitCallBT{
+			CallerBT: CallerBT{
+				Application:    app.Name,
+				Tier:           entities.Tier,
+				Name:           entities.BusinessTransaction,
+				CallsPerMinute: float64(metricData.MetricValues[0].Value),
+			},
+			exitCall: segments[1],
+		})
+	}
+	return bts, nil
+}
+
+// weightedAverage averages the response times weighted by the calls at the same time
+func weightedAverage(art *series.Series, calls *series.Series) float64 {
+	weights := make(map[int64]float64, len(calls.Points))
+	for _, p := range calls.Points {
+		weights[p.Time.UnixNano()] = p.Value
+	}
+	total, weight := 0.0, 0.0
+	for _, p := range art.Points {
+		w := weights[p.Time.UnixNano()]
+		if math.IsNaN(p.Value) || math.IsNaN(w) {
+			continue
+		}
+		total += p.Value * w
+		weight += w
+	}
+	if weight == 0 {
+		return zeroNaN(art.Aggregate(series.Avg))
+	}
+	return total / weight
+}
+
+// weightedMerge merges response time series point by point, weighted by the calls of the same series at that time.
+// Points without calls are averaged unweighted, like weightedAverage does.
+func weightedMerge(arts []*series.Series, calls []*series.Series) *series.Series {
+	type sums struct{ total, weight, plain, n float64 }
+	byTime := make(map[int64]*sums)
+	for i, art := range arts {
+		weights := make(map[int64]float64, len(calls[i].Points))
+		for _, p := range calls[i].Points {
+			weights[p.Time.UnixNano()] = p.Value
+		}
+		for _, p := range art.Points {
+			if math.IsNaN(p.Value) {
+				continue
+			}
+			key := p.Time.UnixNano()
+			if byTime[key] == nil {
+				byTime[key] = &sums{}
+			}
+			if w := weights[key]; !math.IsNaN(w) && w > 0 {
+				byTime[key].total += p.Value * w
+				byTime[key].weight += w
+			}
+			byTime[key].plain += p.Value
+			byTime[key].n++
+		}
+	}
+
+	out := &series.Series{}
+	for key, sum := range byTime {
+		value := sum.plain / sum.n
+		if sum.weight > 0 {
+			value = sum.total / sum.weight
+		}
+		out.Points = append(out.Points, series.Point{Time: time.Unix(0, key), Value: value})
+	}
+	sort.Slice(out.Points, func(i, j int) bool { return out.Points[i].Time.Before(out.Points[j].Time) })
+	return out
+}
+
+// halfOverHalf compares the call weighted response time of the second half of a series with the first half
+func halfOverHalf(art *series.Series, calls *series.Series) series.Comparison {
+	if len(art.Points) < 2 {
+		return series.Comparison{}
+	}
+	half := len(art.Points) / 2
+	first := weightedAverage(&series.Series{Points: art.Points[:half]}, calls)
+	second := weightedAverage(&series.Series{Points: art.Points[half:]}, calls)
+	return series.CompareValues(second, first)
+}
+
+func zeroNaN(v float64) float64 {
+	if math.IsNaN(v) {
+		return 0
+	}
+	return v
+}
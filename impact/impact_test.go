@@ -0,0 +1,59 @@
+/*
+MIT License
+
+Copyright (c) 2026 Cisco Systems, Inc. and its affiliates
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+
+*/
+
+package impact
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cisco-open/appd-client-go/series"
+)
+
+func TestLatencyChangeWeightedByCalls(t *testing.T) {
+	start := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	points := func(values ...float64) *series.Series {
+		s := &series.Series{}
+		for i, v := range values {
+			s.Points = append(s.Points, series.Point{Time: start.Add(time.Duration(i) * time.Minute), Value: v})
+		}
+		return s
+	}
+
+	// a busy application with steady latency and a quiet one getting ten times slower
+	arts := []*series.Series{points(100, 100, 100, 100), points(10, 10, 100, 100)}
+	calls := []*series.Series{points(990, 990, 990, 990), points(10, 10, 10, 10)}
+
+	change := halfOverHalf(weightedMerge(arts, calls), series.Merge("", calls, series.Sum))
+	if change.Previous != 99.1 || change.Current != 100 {
+		t.Errorf("weighted change %+v, want 99.1 -> 100", change)
+	}
+
+	// without calls the response times are averaged unweighted
+	merged := weightedMerge(arts, []*series.Series{{}, {}})
+	if got := merged.Points[0].Value; got != 55 {
+		t.Errorf("unweighted point %v, want 55", got)
+	}
+}
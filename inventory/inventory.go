@@ -0,0 +1,240 @@
+/*
+MIT License
+
+Copyright (c) 2026 Cisco Systems, Inc. and its affiliates
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+
+*/
+
+// Package inventory lists the agents of one or more controllers with parsed versions,
+// groups them by agent type and release and checks them against version policies.
+package inventory
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"text/tabwriter"
+
+	appdrest "github.com/cisco-open/appd-client-go"
+)
+
+// Agent is one app or machine agent reporting for a node
+type Agent struct {
+	Controller  string                `json:"controller"`
+	Application string                `json:"application"`
+	Tier        string                `json:"tier"`
+	Node        string                `json:"node"`
+	NodeID      int                   `json:"nodeId"`
+	MachineName string                `json:"machineName"`
+	OSType      string                `json:"osType"`
+	AgentType   appdrest.AgentType    `json:"agentType"`
+	Version     appdrest.AgentVersion `json:"-"`
+	VersionText string                `json:"version"` // parsed version, empty when unknown
+	Raw         string                `json:"rawVersion"`
+}
+
+// Inventory is the list of agents of one or more controllers
+type Inventory struct {
+	Agents []Agent `json:"agents"`
+}
+
+// Collect reads the agents of the given applications, all APM applications when none are given
+func Collect(client *appdrest.Client, applications ...string) (*Inventory, error) {
+	if len(applications) == 0 {
+		apps, err := client.Application.GetApplications()
+		if err != nil {
+			return nil, err
+		}
+		for _, app := range apps {
+			applications = append(applications, app.Name)
+		}
+	}
+
+	inv := &Inventory{}
+	for _, application := range applications {
+		nodes, err := client.Node.GetNodes(application)
+		if err != nil {
+			return nil, fmt.Errorf("listing nodes of %s: %v", application, err)
+		}
+		for _, node := range nodes {
+			base := Agent{
+				Controller:  client.Controller.Host,
+				Application: application,
+				Tier:        node.TierName,
+				Node:        node.Name,
+				NodeID:      node.ID,
+				MachineName: node.MachineName,
+				OSType:      node.MachineOSType,
+			}
+			if node.AppAgentPresent {
+				agent := base
+				agent.AgentType = node.AgentType
+				agent.setVersion(node.AppAgentVersion)
+				inv.Agents = append(inv.Agents, agent)
+			}
+			if node.MachineAgentPresent {
+				agent := base
+				agent.AgentType = appdrest.AgentMachine
+				agent.setVersion(node.MachineAgentVersion)
+				inv.Agents = append(inv.Agents, agent)
+			}
+		}
+	}
+	inv.sort()
+	return inv, nil
+}
+
+// CollectAll reads the agents of all APM applications of several controllers
+func CollectAll(clients ...*appdrest.Client) (*Inventory, error) {
+	inv := &Inventory{}
+	for _, client := range clients {
+		one, err := Collect(client)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", client.Controller.Host, err)
+		}
+		inv.Agents = append(inv.Agents, one.Agents...)
+	}
+	inv.sort()
+	return inv, nil
+}
+
+func (a *Agent) setVersion(raw string) {
+	a.Raw = raw
+	if v, err := appdrest.ParseAgentVersion(raw); err == nil {
+		a.Version = v
+		a.VersionText = v.String()
+	}
+}
+
+func (inv *Inventory) sort() {
+	sort.SliceStable(inv.Agents, func(i, j int) bool {
+		a, b := inv.Agents[i], inv.Agents[j]
+		for _, pair := range [][2]string{{a.Controller, b.Controller}, {a.Application, b.Application}, {a.Tier, b.Tier}, {a.Node, b.Node}, {string(a.AgentType), string(b.AgentType)}} {
+			if pair[0] != pair[1] {
+				return pair[0] < pair[1]
+			}
+		}
+		return false
+	})
+}
+
+// Group counts the agents of one type and release
+type Group struct {
+	AgentType appdrest.AgentType `json:"agentType"`
+	Release   string             `json:"release"` // major.minor, "unknown" for unparsable versions
+	Newest    string             `json:"newest"`  // newest version of the release, empty for unparsable versions
+	Count     int                `json:"count"`
+}
+
+// Groups counts agents by agent type and release, newest release first
+func (inv *Inventory) Groups() []Group {
+	type key struct {
+		agentType appdrest.AgentType
+		release   string
+	}
+	counts := make(map[key]int)
+	newest := make(map[key]appdrest.AgentVersion)
+	for _, agent := range inv.Agents {
+		release := "unknown"
+		if agent.VersionText != "" {
+			release = agent.Version.Release()
+		}
+		k := key{agent.AgentType, release}
+		counts[k]++
+		if current, ok := newest[k]; !ok || current.Less(agent.Version) {
+			newest[k] = agent.Version
+		}
+	}
+
+	groups := make([]Group, 0, len(counts))
+	for k, count := range counts {
+		group := Group{AgentType: k.agentType, Release: k.release, Count: count}
+		if !newest[k].IsZero() {
+			group.Newest = newest[k].String()
+		}
+		groups = append(groups, group)
+	}
+	sort.Slice(groups, func(i, j int) bool {
+		if groups[i].AgentType != groups[j].AgentType {
+			return groups[i].AgentType < groups[j].AgentType
+		}
+		vi := newest[key{groups[i].AgentType, groups[i].Release}]
+		vj := newest[key{groups[j].AgentType, groups[j].Release}]
+		if vi.Major != vj.Major || vi.Minor != vj.Minor {
+			return vi.Major > vj.Major || (vi.Major == vj.Major && vi.Minor > vj.Minor)
+		}
+		return groups[i].Release < groups[j].Release
+	})
+	return groups
+}
+
+// WriteTable writes one agent per line as an aligned table
+func (inv *Inventory) WriteTable(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "CONTROLLER\tAPPLICATION\tTIER\tNODE\tAGENT TYPE\tVERSION")
+	for _, agent := range inv.Agents {
+		version := agent.VersionText
+		if version == "" {
+			version = "unknown"
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\n", agent.Controller, agent.Application, agent.Tier, agent.Node, agent.AgentType, version)
+	}
+	return tw.Flush()
+}
+
+// WriteGroupTable writes the agent counts by type and release as an aligned table
+func (inv *Inventory) WriteGroupTable(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "AGENT TYPE\tRELEASE\tNEWEST\tCOUNT")
+	for _, group := range inv.Groups() {
+		newest := group.Newest
+		if newest == "" {
+			newest = "unknown"
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%d\n", group.AgentType, group.Release, newest, group.Count)
+	}
+	return tw.Flush()
+}
+
+// WriteCSV writes one agent per row with a header row
+func (inv *Inventory) WriteCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	cw.Write([]string{"controller", "application", "tier", "node", "node_id", "machine_name", "os_type", "agent_type", "version", "raw_version"})
+	for _, agent := range inv.Agents {
+		cw.Write([]string{agent.Controller, agent.Application, agent.Tier, agent.Node, strconv.Itoa(agent.NodeID),
+			agent.MachineName, agent.OSType, string(agent.AgentType), agent.VersionText, agent.Raw})
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// WriteJSON writes the inventory with the groups as indented JSON
+func (inv *Inventory) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(struct {
+		Agents []Agent `json:"agents"`
+		Groups []Group `json:"groups"`
+	}{inv.Agents, inv.Groups()})
+}
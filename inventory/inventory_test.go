@@ -0,0 +1,89 @@
+/*
+MIT License
+
+Copyright (c) 2026 Cisco Systems, Inc. and its affiliates
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+
+*/
+
+package inventory
+
+import (
+	"reflect"
+	"testing"
+
+	appdrest "github.com/cisco-open/appd-client-go"
+)
+
+func agent(agentType appdrest.AgentType, raw string) Agent {
+	a := Agent{AgentType: agentType}
+	a.setVersion(raw)
+	return a
+}
+
+func TestGroups(t *testing.T) {
+	inv := &Inventory{Agents: []Agent{
+		agent(appdrest.AgentJava, "Server Agent #23.8.0.35032 v23.8.0 GA compatible with 4.4.1.0"),
+		agent(appdrest.AgentJava, "Server Agent #23.8.1.35160 v23.8.1 GA compatible with 4.4.1.0"),
+		agent(appdrest.AgentJava, "Server Agent #23.8.0.34800 v23.8.0 GA compatible with 4.4.1.0"), // older, seen last
+		agent(appdrest.AgentJava, "Server Agent v4.5.17.28908 GA #4.5.17.28908 r8e2bd7a7d8b7e7e28e5b3cdd9d4eab5a85f3c36b"),
+		agent(appdrest.AgentJava, "Server Agent v23.11.0.35669 GA #23.11.0.35669 r1a2b3c4d"),
+		agent(appdrest.AgentDotNet, "Server Agent v23.10.0.0 GA #23.10.0.0 r9b05d6a3"),
+		agent(appdrest.AgentNodeJS, "Server Agent v22.3.0.0 GA #22.3.0 r4b1c2d3e Node.js v14.17.0"),
+		agent(appdrest.AgentNodeJS, ""),
+		agent(appdrest.AgentMachine, "Machine Agent v23.7.0.3689 GA compatible with 4.4.1.0 Build Date 2023-07-27 10:53:48"),
+		agent(appdrest.AgentMachine, "Machine Agent v23.7.0.3701 GA compatible with 4.4.1.0 Build Date 2023-08-10 09:12:01"),
+	}}
+
+	want := []Group{
+		{AgentType: appdrest.AgentJava, Release: "23.11", Newest: "23.11.0.35669", Count: 1},
+		{AgentType: appdrest.AgentJava, Release: "23.8", Newest: "23.8.1.35160", Count: 3},
+		{AgentType: appdrest.AgentJava, Release: "4.5", Newest: "4.5.17.28908", Count: 1},
+		{AgentType: appdrest.AgentDotNet, Release: "23.10", Newest: "23.10.0", Count: 1},
+		{AgentType: appdrest.AgentMachine, Release: "23.7", Newest: "23.7.0.3701", Count: 2},
+		{AgentType: appdrest.AgentNodeJS, Release: "22.3", Newest: "22.3.0", Count: 1},
+		{AgentType: appdrest.AgentNodeJS, Release: "unknown", Count: 1},
+	}
+	if got := inv.Groups(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Groups() =\n%+v\nwant\n%+v", got, want)
+	}
+}
+
+func TestCheck(t *testing.T) {
+	inv := &Inventory{Agents: []Agent{
+		agent(appdrest.AgentJava, "Server Agent #23.8.0.35032 v23.8.0 GA compatible with 4.4.1.0"),
+		agent(appdrest.AgentJava, "Server Agent v4.5.17.28908 GA #4.5.17.28908"),
+		agent(appdrest.AgentJava, "unknown"),
+		agent(appdrest.AgentMachine, "Machine Agent v4.5.16.2357 GA Build Date 2019-09-25 11:04:36"),
+	}}
+	policy, err := MinimumVersion(appdrest.AgentJava, "23.0")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	violations := inv.Check(policy)
+	if len(violations) != 2 || violations[0].Agent.Raw != inv.Agents[1].Raw || violations[1].Agent.Raw != "unknown" {
+		t.Errorf("violations %+v", violations)
+	}
+	policy.AllowUnknown = true
+	if violations := inv.Check(policy); len(violations) != 1 {
+		t.Errorf("violations allowing unknown versions %+v", violations)
+	}
+}
@@ -0,0 +1,99 @@
+/*
+MIT License
+
+Copyright (c) 2026 Cisco Systems, Inc. and its affiliates
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+
+*/
+
+package inventory
+
+import (
+	"fmt"
+	"io"
+	"text/tabwriter"
+
+	appdrest "github.com/cisco-open/appd-client-go"
+)
+
+// Policy requires a minimum version for one agent type
+type Policy struct {
+	Name         string
+	AgentType    appdrest.AgentType
+	MinVersion   appdrest.AgentVersion
+	AllowUnknown bool // agents with unparsable versions pass
+}
+
+// MinimumVersion returns a policy flagging agents of a type older than version, e.g. MinimumVersion(appdrest.AgentJava, "23.0")
+func MinimumVersion(agentType appdrest.AgentType, version string) (Policy, error) {
+	v, err := appdrest.ParseAgentVersion(version)
+	if err != nil {
+		return Policy{}, err
+	}
+	return Policy{
+		Name:       fmt.Sprintf("%s older than %s", agentType, version),
+		AgentType:  agentType,
+		MinVersion: v,
+	}, nil
+}
+
+// Violates reports whether an agent breaks the policy
+func (p Policy) Violates(agent Agent) bool {
+	if agent.AgentType != p.AgentType {
+		return false
+	}
+	if agent.VersionText == "" {
+		return !p.AllowUnknown
+	}
+	return agent.Version.Less(p.MinVersion)
+}
+
+// Violation is an agent breaking a policy
+type Violation struct {
+	Policy string `json:"policy"`
+	Agent  Agent  `json:"agent"`
+}
+
+// Check returns the agents breaking any of the policies
+func (inv *Inventory) Check(policies ...Policy) []Violation {
+	var violations []Violation
+	for _, agent := range inv.Agents {
+		for _, policy := range policies {
+			if policy.Violates(agent) {
+				violations = append(violations, Violation{Policy: policy.Name, Agent: agent})
+			}
+		}
+	}
+	return violations
+}
+
+// WriteViolations writes the violations as an aligned table
+func WriteViolations(w io.Writer, violations []Violation) error {
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "POLICY\tCONTROLLER\tAPPLICATION\tTIER\tNODE\tVERSION")
+	for _, v := range violations {
+		version := v.Agent.VersionText
+		if version == "" {
+			version = "unknown"
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\n", v.Policy, v.Agent.Controller, v.Agent.Application, v.Agent.Tier, v.Agent.Node, version)
+	}
+	return tw.Flush()
+}
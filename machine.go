@@ -0,0 +1,264 @@
+/*
+MIT License
+
+Copyright (c) 2026 Cisco Systems, Inc. and its affiliates
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+
+*/
+
+package appdrest
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// Consts for common hardware metrics, relative to the Hardware Resources tree of a machine
+const (
+	MetricHardwareCPUBusy             = "CPU|%Busy"
+	MetricHardwareMemoryUsedPercent   = "Memory|Used %"
+	MetricHardwareMemoryFreeMB        = "Memory|Free (MB)"
+	MetricHardwareVolumesUsedPercent  = "Volumes|Used (%)"
+	MetricHardwareNetworkIncomingKBps = "Network|Incoming KB/sec"
+	MetricHardwareNetworkOutgoingKBps = "Network|Outgoing KB/sec"
+)
+
+// MachineTreeRoot is the top of the machine hierarchy in the Server Visibility metric tree
+const MachineTreeRoot = "Root"
+
+// DefaultMachineProcessLimit is the number of processes GetMachineProcesses requests when no limit is given
+const DefaultMachineProcessLimit = 1000
+
+// DANGER ZONE
+// following types are used for UNPUBLISHED api call and it may change in the future
+
+// MachineNetworkInterface is a network interface of a machine
+type MachineNetworkInterface struct {
+	Name       string `json:"name"`
+	MacAddress string `json:"macAddress"`
+	IPAddress  string `json:"ipAddress"`
+	IP6Address string `json:"ip6Address"`
+	SpeedMbps  int    `json:"speedMbps"`
+	Enabled    string `json:"enabled"`
+	Plugged    string `json:"plugged"`
+	State      string `json:"state"`
+	Duplex     string `json:"duplex"`
+	MTU        int    `json:"mtuMb"`
+	NicType    string `json:"nicType"`
+}
+
+// MachineVolume is a disk volume of a machine
+type MachineVolume struct {
+	Name       string   `json:"name"`
+	MountPoint string   `json:"mountPoint"`
+	SizeMb     int64    `json:"sizeMb"`
+	VolumeType string   `json:"volumeType"`
+	Partitions []string `json:"partitions"`
+}
+
+// MachineCPU is a processor of a machine
+type MachineCPU struct {
+	CPUID          string `json:"cpuId"`
+	Vendor         string `json:"vendor"`
+	Model          string `json:"model"`
+	CoreCount      int    `json:"coreCount"`
+	LogicalCount   int    `json:"logicalCount"`
+	SpeedMhz       int    `json:"speedMhz"`
+	HyperThreading string `json:"hyperThreading"`
+}
+
+// MachineMemory is the memory of a machine by type, e.g. "Physical" or "Swap"
+type MachineMemory struct {
+	Type   string `json:"type"`
+	SizeMb int64  `json:"sizeMb"`
+}
+
+// Machine is a host or container monitored by a machine agent with Server Visibility
+type Machine struct {
+	ID                int64                     `json:"id"`
+	Name              string                    `json:"name"`
+	HostID            string                    `json:"hostId"`
+	SimNodeID         int                       `json:"simNodeId"`
+	Type              string                    `json:"type"` // PHYSICAL, VIRTUAL or CONTAINER
+	Historical        bool                      `json:"historical"`
+	SimEnabled        bool                      `json:"simEnabled"`
+	DynamicMonitoring string                    `json:"dynamicMonitoringMode"`
+	Hierarchy         []string                  `json:"hierarchy"`
+	Properties        map[string]string         `json:"properties"`
+	Tags              map[string][]string       `json:"tags"`
+	Memory            map[string]MachineMemory  `json:"memory"`
+	Volumes           []MachineVolume           `json:"volumes"`
+	NetworkInterfaces []MachineNetworkInterface `json:"networkInterfaces"`
+	CPUs              []MachineCPU              `json:"cpus"`
+}
+
+// MachineProcess is a process running on a machine
+type MachineProcess struct {
+	ProcessID       int               `json:"processId"`
+	ParentProcessID int               `json:"parentProcessId"`
+	Name            string            `json:"name"`
+	CommandLine     string            `json:"commandLine"`
+	ProcessClass    string            `json:"processClass"`
+	StartTime       int64             `json:"startTime"`
+	EndTime         int64             `json:"endTime"`
+	Properties      map[string]string `json:"properties"`
+}
+
+// MachineContainer is a container running on a monitored host
+type MachineContainer struct {
+	ContainerID  string            `json:"containerId"`
+	Name         string            `json:"name"`
+	ImageName    string            `json:"imageName"`
+	ContainerIDs []string          `json:"containerIds"`
+	HostID       string            `json:"hostId"`
+	MachineID    int64             `json:"machineId"`
+	StartedAt    int64             `json:"startedAt"`
+	Properties   map[string]string `json:"properties"`
+}
+
+// DANGER ZONE END
+
+// Property returns a machine property such as "OS|Architecture", empty when not set
+func (m *Machine) Property(name string) string {
+	return m.Properties[name]
+}
+
+// MetricPath returns the path of a hardware metric of the machine in the Server Visibility application
+func (m *Machine) MetricPath(metric string) MetricPath {
+	path := NewMetricPath(MetricTreeApplicationInfrastructure)
+	if len(m.Hierarchy) == 0 {
+		path.Segments = append(path.Segments, MachineTreeRoot)
+	} else {
+		path.Segments = append(path.Segments, m.Hierarchy...)
+	}
+	path.Segments = append(path.Segments, MetricTreeIndividualNodes, m.Name, MetricTreeHardwareResources)
+	return path.Append(metric)
+}
+
+// Kubernetes returns cluster, namespace and pod of a container, empty when not running on Kubernetes
+func (c *MachineContainer) Kubernetes() (cluster string, namespace string, pod string) {
+	return c.Properties["Kubernetes|Cluster Name"], c.Properties["Kubernetes|Namespace"], c.Properties["Kubernetes|Pod Name"]
+}
+
+// MachineService intermediates Server Visibility requests
+type MachineService service
+
+// DANGER ZONE
+// this is an UNPUBLISHED API call - it may change in the future
+
+// GetMachines obtains all machines with Server Visibility
+func (s *MachineService) GetMachines() ([]*Machine, error) {
+
+	url := "controller/sim/v2/user/machines"
+
+	var machines []*Machine
+	err := s.client.RestInternal("GET", url, &machines, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return machines, nil
+}
+
+// GetMachine obtains a machine with its properties, volumes, network interfaces and CPUs
+func (s *MachineService) GetMachine(machineID int64) (*Machine, error) {
+
+	url := fmt.Sprintf("controller/sim/v2/user/machines/%d", machineID)
+
+	var machine *Machine
+	err := s.client.RestInternal("GET", url, &machine, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return machine, nil
+}
+
+// GetMachineProcesses obtains at most limit processes seen on a machine between startTime and endTime,
+// a limit of 0 or less uses DefaultMachineProcessLimit
+func (s *MachineService) GetMachineProcesses(machineID int64, startTime time.Time, endTime time.Time, limit int) ([]*MachineProcess, error) {
+
+	if limit <= 0 {
+		limit = DefaultMachineProcessLimit
+	}
+
+	url := fmt.Sprintf("controller/sim/v2/user/machines/%d/processes?timeRangeStart=%d&timeRangeEnd=%d&limit=%d",
+		machineID, startTime.UnixMilli(), endTime.UnixMilli(), limit)
+
+	var processes []*MachineProcess
+	err := s.client.RestInternal("GET", url, &processes, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return processes, nil
+}
+
+// GetMachineContainers obtains the containers running on a host machine
+func (s *MachineService) GetMachineContainers(machineID int64) ([]*MachineContainer, error) {
+
+	url := fmt.Sprintf("controller/sim/v2/user/machines/%d/containers", machineID)
+
+	var containers []*MachineContainer
+	err := s.client.RestInternal("GET", url, &containers, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return containers, nil
+}
+
+// DANGER ZONE END
+
+// GetMachineForNode returns the machine an app agent node runs on
+func (s *MachineService) GetMachineForNode(node *Node) (*Machine, error) {
+	if node.MachineID == 0 {
+		return nil, fmt.Errorf("node %s has no machine", node.Name)
+	}
+	return s.GetMachine(int64(node.MachineID))
+}
+
+// GetMachineByHostID returns the machine with the given host ID, e.g. as configured for the machine agent
+func (s *MachineService) GetMachineByHostID(hostID string) (*Machine, error) {
+	machines, err := s.GetMachines()
+	if err != nil {
+		return nil, err
+	}
+	for _, machine := range machines {
+		if machine.HostID == hostID {
+			return machine, nil
+		}
+	}
+	return nil, fmt.Errorf("no machine with host ID %s", hostID)
+}
+
+// GetHardwareMetricData obtains a hardware metric of a machine from the Server Visibility application
+func (s *MachineService) GetHardwareMetricData(machine *Machine, metric string, rollup bool, timeRangeType string, durationInMins int, startTime time.Time, endTime time.Time) ([]*MetricData, error) {
+	apps, err := s.client.Application.GetAllInternalApplications()
+	if err != nil {
+		return nil, err
+	}
+	if apps.SimApplication.ID == 0 {
+		return nil, fmt.Errorf("server visibility is not enabled on this controller")
+	}
+	path := machine.MetricPath(metric)
+	return s.client.MetricData.GetMetricData(strconv.Itoa(apps.SimApplication.ID), path.String(), rollup, timeRangeType, durationInMins, startTime, endTime)
+}
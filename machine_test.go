@@ -0,0 +1,53 @@
+/*
+MIT License
+
+Copyright (c) 2026 Cisco Systems, Inc. and its affiliates
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+
+*/
+
+package appdrest
+
+import (
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestGetMachineProcessesLimit(t *testing.T) {
+	var limit string
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/controller/sim/v2/user/machines/7/processes" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+		}
+		limit = r.URL.Query().Get("limit")
+		w.Write([]byte("[]"))
+	}))
+
+	for given, want := range map[int]int{0: DefaultMachineProcessLimit, 5000: 5000} {
+		if _, err := client.Machine.GetMachineProcesses(7, time.Now().Add(-time.Hour), time.Now(), given); err != nil {
+			t.Fatal(err)
+		}
+		if limit != strconv.Itoa(want) {
+			t.Errorf("limit %d requested %s, want %d", given, limit, want)
+		}
+	}
+}
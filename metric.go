@@ -59,6 +59,17 @@ type Metric struct {
 	Type string `json:"type"`
 }
 
+// MetricQuery describes a metric-data request for one application and metric path
+// Added 2026 Cisco Systems, Inc.
+type MetricQuery struct {
+	Application string // application name or ID
+	MetricPath  string // metric path, may contain "*" wildcards
+	Rollup      bool
+	Baseline    string // baseline name for GetMetricDataWithBaseline, empty for the default baseline
+
+	MaxBaselineMetrics int // metrics GetMetricDataWithBaseline requests baselines for, defaults to DefaultMaxBaselineMetrics
+}
+
 // Consts for the technique used to obtain metric data
 const (
 	TimeBEFORENOW    = "BEFORE_NOW"
@@ -67,6 +78,27 @@ const (
 	TimeBETWEENTIMES = "BETWEEN_TIMES"
 )
 
+// TimeSpec bundles the time range arguments taken by GetMetricData
+// Added 2026 Cisco Systems, Inc.
+type TimeSpec struct {
+	Type           string // one of the Time* consts
+	DurationInMins int
+	StartTime      time.Time
+	EndTime        time.Time
+}
+
+// BeforeNow returns a TimeSpec for the last d
+// Added 2026 Cisco Systems, Inc.
+func BeforeNow(d time.Duration) TimeSpec {
+	return TimeSpec{Type: TimeBEFORENOW, DurationInMins: int(d / time.Minute)}
+}
+
+// BetweenTimes returns a TimeSpec from startTime to endTime
+// Added 2026 Cisco Systems, Inc.
+func BetweenTimes(startTime time.Time, endTime time.Time) TimeSpec {
+	return TimeSpec{Type: TimeBETWEENTIMES, StartTime: startTime, EndTime: endTime}
+}
+
 // MetricDataService intermediates MetricData requests
 type MetricDataService service
 
@@ -116,3 +148,47 @@ func (s *MetricDataService) GetMetricHierarchy(appIDOrName string, metricPath st
 
 	return metrics, nil
 }
+
+// GetMetricDataChunked obtains metrics between startTime and endTime in windows of chunk duration
+// and passes every window to fn, so long ranges never have to be held in memory at once.
+// Values are trimmed to the window they start in, a bucket is never delivered twice
+// and the bucket containing startTime is delivered as well.
+// Added 2026 Cisco Systems, Inc.
+func (s *MetricDataService) GetMetricDataChunked(appIDOrName string, metricPath string, rollup bool, startTime time.Time, endTime time.Time, chunk time.Duration, fn func([]*MetricData) error) error {
+
+	if chunk < time.Minute {
+		return fmt.Errorf("Metric API: chunk must be at least one minute, got %v", chunk)
+	}
+
+	// buckets start on the minute, a startTime within one still delivers that bucket
+	start := startTime.Truncate(time.Minute)
+	for from := start; from.Before(endTime); from = from.Add(chunk) {
+		to := from.Add(chunk)
+		if to.After(endTime) {
+			to = endTime
+		}
+
+		metrics, err := s.GetMetricData(appIDOrName, metricPath, rollup, TimeBETWEENTIMES, 0, from, to)
+		if err != nil {
+			return err
+		}
+
+		for _, metric := range metrics {
+			values := metric.MetricValues[:0]
+			for _, value := range metric.MetricValues {
+				// the first window keeps coarser buckets that began before start
+				if (from.Equal(start) || value.StartTimeInMillis >= from.UnixMilli()) && value.StartTimeInMillis < to.UnixMilli() {
+					values = append(values, value)
+				}
+			}
+			metric.MetricValues = values
+		}
+
+		err = fn(metrics)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
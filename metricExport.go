@@ -0,0 +1,242 @@
+/*
+MIT License
+
+Copyright (c) 2026 Cisco Systems, Inc. and its affiliates
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+
+*/
+
+package appdrest
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+)
+
+// DefaultExportSegments is the number of path segment columns written when
+// MetricExportOptions.Segments is not set
+const DefaultExportSegments = 8
+
+// MetricExportOptions describes the context written next to every exported row
+type MetricExportOptions struct {
+	Controller   string // controller host name written in every row
+	Application  string // application name or ID written in every row
+	Segments     int    // number of segment columns for CSV and Parquet, remaining segments are kept in the last one
+	RowGroupSize int    // rows buffered per Parquet row group, defaults to DefaultParquetRowGroupSize
+}
+
+// MetricExportRow is one metric value in long format
+type MetricExportRow struct {
+	Controller        string    `json:"controller"`
+	Application       string    `json:"application"`
+	MetricPath        string    `json:"metric_path"`
+	Segments          []string  `json:"segments"`
+	Timestamp         time.Time `json:"timestamp"`
+	Value             int64     `json:"value"`
+	Min               int64     `json:"min"`
+	Max               int64     `json:"max"`
+	Count             int64     `json:"count"`
+	Sum               int64     `json:"sum"`
+	StandardDeviation int64     `json:"standard_deviation"`
+}
+
+// MetricEncoder writes MetricData as long format rows to a stream.
+// Encode can be called any number of times, e.g. once per chunk of
+// GetMetricDataChunked, Close must be called once all data was written.
+type MetricEncoder interface {
+	Encode(metrics []*MetricData) error
+	Close() error
+}
+
+// MetricExportRows flattens MetricData into one row per metric value
+func MetricExportRows(metrics []*MetricData, opts MetricExportOptions) []MetricExportRow {
+	var rows []MetricExportRow
+	for _, metric := range metrics {
+		if metric == nil {
+			continue
+		}
+		segments := ParseMetricPath(metric.MetricPath).Segments
+		for _, value := range metric.MetricValues {
+			rows = append(rows, MetricExportRow{
+				Controller:        opts.Controller,
+				Application:       opts.Application,
+				MetricPath:        metric.MetricPath,
+				Segments:          segments,
+				Timestamp:         time.UnixMilli(value.StartTimeInMillis).UTC(),
+				Value:             int64(value.Value),
+				Min:               int64(value.Min),
+				Max:               int64(value.Max),
+				Count:             int64(value.Count),
+				Sum:               int64(value.Sum),
+				StandardDeviation: int64(value.StandardDeviation),
+			})
+		}
+	}
+	return rows
+}
+
+// exportColumns returns the flat column names shared by CSV and Parquet
+func exportColumns(segments int) []string {
+	columns := []string{"controller", "application", "metric_path"}
+	for i := 1; i <= segments; i++ {
+		columns = append(columns, fmt.Sprintf("segment_%d", i))
+	}
+	return append(columns, "timestamp", "value", "min", "max", "count", "sum", "standard_deviation")
+}
+
+// fixedSegments spreads path segments over a fixed number of columns,
+// the remaining segments are kept escaped in the last column
+func fixedSegments(segments []string, n int) []string {
+	fixed := make([]string, n)
+	for i := 0; i < n && i < len(segments); i++ {
+		fixed[i] = segments[i]
+	}
+	if len(segments) > n && n > 0 {
+		fixed[n-1] = NewMetricPath(segments[n-1:]...).String()
+	}
+	return fixed
+}
+
+func exportSegments(opts MetricExportOptions) int {
+	if opts.Segments <= 0 {
+		return DefaultExportSegments
+	}
+	return opts.Segments
+}
+
+type metricCSVEncoder struct {
+	w        *csv.Writer
+	opts     MetricExportOptions
+	segments int
+	header   bool
+}
+
+// NewMetricCSVEncoder returns an encoder writing CSV with a header row
+func NewMetricCSVEncoder(w io.Writer, opts MetricExportOptions) MetricEncoder {
+	return &metricCSVEncoder{w: csv.NewWriter(w), opts: opts, segments: exportSegments(opts)}
+}
+
+func (e *metricCSVEncoder) Encode(metrics []*MetricData) error {
+	if !e.header {
+		if err := e.w.Write(exportColumns(e.segments)); err != nil {
+			return err
+		}
+		e.header = true
+	}
+	for _, row := range MetricExportRows(metrics, e.opts) {
+		record := []string{row.Controller, row.Application, row.MetricPath}
+		record = append(record, fixedSegments(row.Segments, e.segments)...)
+		record = append(record,
+			row.Timestamp.Format(time.RFC3339),
+			strconv.FormatInt(row.Value, 10),
+			strconv.FormatInt(row.Min, 10),
+			strconv.FormatInt(row.Max, 10),
+			strconv.FormatInt(row.Count, 10),
+			strconv.FormatInt(row.Sum, 10),
+			strconv.FormatInt(row.StandardDeviation, 10),
+		)
+		if err := e.w.Write(record); err != nil {
+			return err
+		}
+	}
+	e.w.Flush()
+	return e.w.Error()
+}
+
+func (e *metricCSVEncoder) Close() error {
+	if !e.header {
+		return e.Encode(nil)
+	}
+	e.w.Flush()
+	return e.w.Error()
+}
+
+type metricJSONLEncoder struct {
+	enc  *json.Encoder
+	opts MetricExportOptions
+}
+
+// NewMetricJSONLEncoder returns an encoder writing one JSON object per line
+func NewMetricJSONLEncoder(w io.Writer, opts MetricExportOptions) MetricEncoder {
+	return &metricJSONLEncoder{enc: json.NewEncoder(w), opts: opts}
+}
+
+func (e *metricJSONLEncoder) Encode(metrics []*MetricData) error {
+	for _, row := range MetricExportRows(metrics, e.opts) {
+		if err := e.enc.Encode(row); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (e *metricJSONLEncoder) Close() error {
+	return nil
+}
+
+type metricParquetEncoder struct {
+	pw       *parquetWriter
+	opts     MetricExportOptions
+	segments int
+}
+
+// NewMetricParquetEncoder returns an encoder writing an uncompressed Parquet file.
+// Rows are buffered only up to one row group, the file footer is written by Close.
+func NewMetricParquetEncoder(w io.Writer, opts MetricExportOptions) MetricEncoder {
+	segments := exportSegments(opts)
+	var columns []parquetColumn
+	for _, name := range exportColumns(segments) {
+		switch name {
+		case "timestamp":
+			columns = append(columns, parquetColumn{name: name, kind: parquetInt64, converted: parquetTimestampMillis})
+		case "value", "min", "max", "count", "sum", "standard_deviation":
+			columns = append(columns, parquetColumn{name: name, kind: parquetInt64, converted: parquetNoConversion})
+		default:
+			columns = append(columns, parquetColumn{name: name, kind: parquetByteArray, converted: parquetUTF8})
+		}
+	}
+	return &metricParquetEncoder{
+		pw:       newParquetWriter(w, columns, opts.RowGroupSize),
+		opts:     opts,
+		segments: segments,
+	}
+}
+
+func (e *metricParquetEncoder) Encode(metrics []*MetricData) error {
+	for _, row := range MetricExportRows(metrics, e.opts) {
+		values := []interface{}{row.Controller, row.Application, row.MetricPath}
+		for _, segment := range fixedSegments(row.Segments, e.segments) {
+			values = append(values, segment)
+		}
+		values = append(values, row.Timestamp.UnixMilli(), row.Value, row.Min, row.Max, row.Count, row.Sum, row.StandardDeviation)
+		if err := e.pw.writeRow(values); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (e *metricParquetEncoder) Close() error {
+	return e.pw.close()
+}
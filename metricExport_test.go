@@ -0,0 +1,347 @@
+/*
+MIT License
+
+Copyright (c) 2026 Cisco Systems, Inc. and its affiliates
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+
+*/
+
+package appdrest
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+	"testing"
+	"time"
+)
+
+var testExportOptions = MetricExportOptions{Controller: "acme.saas.appdynamics.com", Application: "shop", Segments: 3, RowGroupSize: 2}
+
+func testExportMetrics() []*MetricData {
+	minute := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC).UnixMilli()
+	return []*MetricData{
+		{MetricPath: `Business Transaction Performance|Business Transactions|web|/pay\|card|Calls per Minute`, MetricValues: []MetricValue{
+			{StartTimeInMillis: minute, Value: 12, Min: 1, Max: 30, Count: 12, Sum: 140, StandardDeviation: 4},
+			{StartTimeInMillis: minute + 60000, Value: 7, Min: 2, Max: 9, Count: 7, Sum: 49},
+		}},
+		{MetricPath: "Overall Application Performance|Errors per Minute", MetricValues: []MetricValue{
+			{StartTimeInMillis: minute, Value: 0},
+			{StartTimeInMillis: minute + 60000, Value: -1},
+			{StartTimeInMillis: minute + 120000, Value: 3},
+		}},
+		nil,
+	}
+}
+
+// testExportRecords are the rows of testExportMetrics as written by the CSV and Parquet encoders
+func testExportRecords() [][]string {
+	var records [][]string
+	for _, row := range MetricExportRows(testExportMetrics(), testExportOptions) {
+		record := []string{row.Controller, row.Application, row.MetricPath}
+		record = append(record, fixedSegments(row.Segments, testExportOptions.Segments)...)
+		record = append(record, strconv.FormatInt(row.Timestamp.UnixMilli(), 10))
+		for _, v := range []int64{row.Value, row.Min, row.Max, row.Count, row.Sum, row.StandardDeviation} {
+			record = append(record, strconv.FormatInt(v, 10))
+		}
+		records = append(records, record)
+	}
+	return records
+}
+
+func TestMetricExportSegments(t *testing.T) {
+	rows := MetricExportRows(testExportMetrics(), testExportOptions)
+	if len(rows) != 5 {
+		t.Fatalf("%d rows, want 5", len(rows))
+	}
+	got := fixedSegments(rows[0].Segments, 3)
+	want := []string{"Business Transaction Performance", "Business Transactions", `web|/pay\|card|Calls per Minute`}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("segments %q, want %q", got, want)
+	}
+	if rows[0].Segments[3] != "/pay|card" {
+		t.Errorf("escaped BT name split: %q", rows[0].Segments)
+	}
+}
+
+func TestMetricCSVEncoder(t *testing.T) {
+	var out bytes.Buffer
+	enc := NewMetricCSVEncoder(&out, testExportOptions)
+	metrics := testExportMetrics()
+	if err := enc.Encode(metrics[:1]); err != nil {
+		t.Fatal(err)
+	}
+	if err := enc.Encode(metrics[1:]); err != nil {
+		t.Fatal(err)
+	}
+	if err := enc.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	records, err := csv.NewReader(&out).ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(records[0], exportColumns(3)) {
+		t.Errorf("header %q", records[0])
+	}
+	want := testExportRecords()
+	for _, record := range records[1:] {
+		timestamp, err := time.Parse(time.RFC3339, record[6])
+		if err != nil {
+			t.Fatal(err)
+		}
+		record[6] = strconv.FormatInt(timestamp.UnixMilli(), 10)
+	}
+	if !reflect.DeepEqual(records[1:], want) {
+		t.Errorf("records\n%q\nwant\n%q", records[1:], want)
+	}
+
+	out.Reset()
+	if err := NewMetricCSVEncoder(&out, testExportOptions).Close(); err != nil || out.Len() == 0 {
+		t.Errorf("empty export without header: %q, %v", out.String(), err)
+	}
+}
+
+func TestMetricJSONLEncoder(t *testing.T) {
+	var out bytes.Buffer
+	enc := NewMetricJSONLEncoder(&out, testExportOptions)
+	if err := enc.Encode(testExportMetrics()); err != nil {
+		t.Fatal(err)
+	}
+	if err := enc.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	var rows []MetricExportRow
+	scanner := bufio.NewScanner(&out)
+	for scanner.Scan() {
+		var row MetricExportRow
+		if err := json.Unmarshal(scanner.Bytes(), &row); err != nil {
+			t.Fatalf("line %q: %v", scanner.Text(), err)
+		}
+		rows = append(rows, row)
+	}
+	if want := MetricExportRows(testExportMetrics(), testExportOptions); !reflect.DeepEqual(rows, want) {
+		t.Errorf("rows\n%+v\nwant\n%+v", rows, want)
+	}
+}
+
+func TestMetricParquetEncoder(t *testing.T) {
+	var out bytes.Buffer
+	enc := NewMetricParquetEncoder(&out, testExportOptions)
+	metrics := testExportMetrics()
+	if err := enc.Encode(metrics[:1]); err != nil {
+		t.Fatal(err)
+	}
+	if err := enc.Encode(metrics[1:]); err != nil {
+		t.Fatal(err)
+	}
+	if err := enc.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	columns, records := readParquet(t, out.Bytes())
+	if !reflect.DeepEqual(columns, exportColumns(3)) {
+		t.Errorf("columns %q", columns)
+	}
+	if want := testExportRecords(); !reflect.DeepEqual(records, want) {
+		t.Errorf("records\n%q\nwant\n%q", records, want)
+	}
+
+	// the default segments need more than 15 schema elements, the long list header
+	out.Reset()
+	if err := NewMetricParquetEncoder(&out, MetricExportOptions{}).Close(); err != nil {
+		t.Fatal(err)
+	}
+	columns, records = readParquet(t, out.Bytes())
+	if len(records) != 0 || !reflect.DeepEqual(columns, exportColumns(DefaultExportSegments)) {
+		t.Errorf("empty export has %d rows and columns %q", len(records), columns)
+	}
+}
+
+// readParquet reads a file written by parquetWriter, values are returned formatted as strings
+func readParquet(t *testing.T, file []byte) ([]string, [][]string) {
+	t.Helper()
+	if len(file) < 12 || string(file[:4]) != parquetMagic || string(file[len(file)-4:]) != parquetMagic {
+		t.Fatalf("missing magic in %d bytes", len(file))
+	}
+	footerLength := int(binary.LittleEndian.Uint32(file[len(file)-8:]))
+	footer := file[len(file)-8-footerLength : len(file)-8]
+	meta, n := readThriftStruct(t, footer)
+	if n != len(footer) {
+		t.Fatalf("footer has %d bytes, read %d", len(footer), n)
+	}
+
+	var columns []string
+	var kinds []int64
+	for i, element := range meta[2].([]interface{}) {
+		element := element.(map[int16]interface{})
+		if i == 0 {
+			if element[5].(int64) != int64(len(meta[2].([]interface{}))-1) {
+				t.Errorf("schema root has %v children", element[5])
+			}
+			continue
+		}
+		if element[3].(int64) != 0 {
+			t.Errorf("column %s is not REQUIRED", element[4])
+		}
+		columns = append(columns, string(element[4].([]byte)))
+		kinds = append(kinds, element[1].(int64))
+	}
+
+	var records [][]string
+	for _, group := range meta[4].([]interface{}) {
+		group := group.(map[int16]interface{})
+		rows := int(group[3].(int64))
+		groupRecords := make([][]string, rows)
+		for i, chunk := range group[1].([]interface{}) {
+			chunkMeta := chunk.(map[int16]interface{})[3].(map[int16]interface{})
+			offset := chunkMeta[9].(int64)
+			if chunkMeta[5].(int64) != int64(rows) {
+				t.Errorf("column %s has %v values in a group of %d rows", columns[i], chunkMeta[5], rows)
+			}
+			page, n := readThriftStruct(t, file[offset:])
+			if values := page[5].(map[int16]interface{})[1].(int64); values != int64(rows) {
+				t.Errorf("page of %s has %d values, want %d", columns[i], values, rows)
+			}
+			if size := int64(n) + page[3].(int64); size != chunkMeta[7].(int64) {
+				t.Errorf("chunk of %s has %d bytes, metadata says %d", columns[i], size, chunkMeta[7])
+			}
+			data := bytes.NewReader(file[offset+int64(n) : offset+int64(n)+page[3].(int64)])
+			for row := 0; row < rows; row++ {
+				groupRecords[row] = append(groupRecords[row], readPlainValue(t, data, kinds[i]))
+			}
+			if data.Len() != 0 {
+				t.Errorf("page of %s has %d bytes left", columns[i], data.Len())
+			}
+		}
+		records = append(records, groupRecords...)
+	}
+	if int64(len(records)) != meta[3].(int64) {
+		t.Errorf("read %d rows, footer says %v", len(records), meta[3])
+	}
+	return columns, records
+}
+
+func readPlainValue(t *testing.T, data *bytes.Reader, kind int64) string {
+	t.Helper()
+	switch int32(kind) {
+	case parquetInt64:
+		var v int64
+		if err := binary.Read(data, binary.LittleEndian, &v); err != nil {
+			t.Fatal(err)
+		}
+		return strconv.FormatInt(v, 10)
+	case parquetByteArray:
+		var length uint32
+		if err := binary.Read(data, binary.LittleEndian, &length); err != nil {
+			t.Fatal(err)
+		}
+		v := make([]byte, length)
+		if _, err := io.ReadFull(data, v); err != nil {
+			t.Fatal(err)
+		}
+		return string(v)
+	}
+	t.Fatalf("unknown physical type %d", kind)
+	return ""
+}
+
+// readThriftStruct decodes a Thrift compact protocol struct into field values by ID
+// and returns the number of bytes read. Integers are returned as int64, binaries as []byte.
+func readThriftStruct(t *testing.T, b []byte) (map[int16]interface{}, int) {
+	t.Helper()
+	r := bytes.NewReader(b)
+	fields, err := readThriftFields(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return fields, len(b) - r.Len()
+}
+
+func readThriftFields(r *bytes.Reader) (map[int16]interface{}, error) {
+	fields := make(map[int16]interface{})
+	var id int16
+	for {
+		header, err := r.ReadByte()
+		if err != nil {
+			return nil, err
+		}
+		if header == 0 {
+			return fields, nil
+		}
+		if delta := int16(header >> 4); delta != 0 {
+			id += delta
+		} else {
+			v, err := binary.ReadVarint(r)
+			if err != nil {
+				return nil, err
+			}
+			id = int16(v)
+		}
+		fields[id], err = readThriftValue(r, header&0x0f)
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
+func readThriftValue(r *bytes.Reader, kind byte) (interface{}, error) {
+	switch kind {
+	case thriftI32, thriftI64:
+		return binary.ReadVarint(r)
+	case thriftBinary:
+		length, err := binary.ReadUvarint(r)
+		if err != nil {
+			return nil, err
+		}
+		v := make([]byte, length)
+		_, err = io.ReadFull(r, v)
+		return v, err
+	case thriftList:
+		header, err := r.ReadByte()
+		if err != nil {
+			return nil, err
+		}
+		size := uint64(header >> 4)
+		if size == 15 {
+			if size, err = binary.ReadUvarint(r); err != nil {
+				return nil, err
+			}
+		}
+		list := make([]interface{}, size)
+		for i := range list {
+			if list[i], err = readThriftValue(r, header&0x0f); err != nil {
+				return nil, err
+			}
+		}
+		return list, nil
+	case thriftStruct:
+		return readThriftFields(r)
+	}
+	return nil, fmt.Errorf("unsupported thrift type %d", kind)
+}
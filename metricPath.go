@@ -0,0 +1,394 @@
+/*
+MIT License
+
+Copyright (c) 2026 Cisco Systems, Inc. and its affiliates
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+
+*/
+
+package appdrest
+
+import (
+	"strings"
+)
+
+// MetricPathKind identifies the metric tree a metric path belongs to
+type MetricPathKind string
+
+// Consts for the known metric path templates
+const (
+	MetricPathUnknown             MetricPathKind = "UNKNOWN"
+	MetricPathApplication         MetricPathKind = "APPLICATION"
+	MetricPathBusinessTransaction MetricPathKind = "BUSINESS_TRANSACTION"
+	MetricPathTier                MetricPathKind = "TIER"
+	MetricPathNode                MetricPathKind = "NODE"
+	MetricPathBackend             MetricPathKind = "BACKEND"
+	MetricPathJVM                 MetricPathKind = "JVM"
+	MetricPathCLR                 MetricPathKind = "CLR"
+	MetricPathHardware            MetricPathKind = "HARDWARE"
+	MetricPathAgent               MetricPathKind = "AGENT"
+	MetricPathCustom              MetricPathKind = "CUSTOM"
+	MetricPathInfrastructure      MetricPathKind = "INFRASTRUCTURE"
+)
+
+// Consts for the fixed segments of the metric tree
+const (
+	MetricTreeBusinessTransactionPerformance = "Business Transaction Performance"
+	MetricTreeBusinessTransactions           = "Business Transactions"
+	MetricTreeOverallApplicationPerformance  = "Overall Application Performance"
+	MetricTreeApplicationInfrastructure      = "Application Infrastructure Performance"
+	MetricTreeBackends                       = "Backends"
+	MetricTreeIndividualNodes                = "Individual Nodes"
+	MetricTreeJVM                            = "JVM"
+	MetricTreeCLR                            = "CLR"
+	MetricTreeHardwareResources              = "Hardware Resources"
+	MetricTreeAgent                          = "Agent"
+	MetricTreeCustomMetrics                  = "Custom Metrics"
+)
+
+// Consts for common metric names
+const (
+	MetricCallsPerMinute      = "Calls per Minute"
+	MetricAverageResponseTime = "Average Response Time (ms)"
+	MetricErrorsPerMinute     = "Errors per Minute"
+	MetricSlowCalls           = "Number of Slow Calls"
+	MetricVerySlowCalls       = "Number of Very Slow Calls"
+	MetricStallCount          = "Stall Count"
+	MetricAgentAvailability   = "Agent|App|Availability"
+	MetricMachineAvailability = "Agent|Machine|Availability"
+)
+
+// MetricPathWildcard matches any text within one segment
+const MetricPathWildcard = "*"
+
+// MetricPath is an AppDynamics metric path split into its segments.
+// Segments are unescaped, a "|" inside an entity name is kept as part of its segment.
+type MetricPath struct {
+	Segments []string
+}
+
+// MetricPathEntities are the entity names extracted from a known metric path template
+type MetricPathEntities struct {
+	Kind                MetricPathKind
+	Tier                string
+	Node                string
+	BusinessTransaction string
+	Backend             string
+	Metric              string // remaining metric path below the entity, escaped
+}
+
+// ParseMetricPath splits a metric path into segments.
+// "\|" is read as a literal "|" and "\\" as a literal "\" inside a segment.
+func ParseMetricPath(metricPath string) MetricPath {
+	var segments []string
+	var segment strings.Builder
+	for i := 0; i < len(metricPath); i++ {
+		c := metricPath[i]
+		if c == '\\' && i+1 < len(metricPath) && (metricPath[i+1] == '|' || metricPath[i+1] == '\\') {
+			segment.WriteByte(metricPath[i+1])
+			i++
+			continue
+		}
+		if c == '|' {
+			segments = append(segments, segment.String())
+			segment.Reset()
+			continue
+		}
+		segment.WriteByte(c)
+	}
+	segments = append(segments, segment.String())
+	return MetricPath{Segments: segments}
+}
+
+// EscapeMetricPathSegment escapes "|" in an entity name so it stays a single segment.
+// A backslash is only escaped before "|" or "\", a trailing one is kept literal like ParseMetricPath reads it.
+func EscapeMetricPathSegment(segment string) string {
+	return escapeMetricPathSegment(segment, false)
+}
+
+// escapeMetricPathSegment escapes a segment, a trailing backslash is escaped when a "|" separator follows
+func escapeMetricPathSegment(segment string, separated bool) string {
+	var b strings.Builder
+	for i := 0; i < len(segment); i++ {
+		c := segment[i]
+		switch {
+		case c == '|':
+			b.WriteString(`\|`)
+		case c == '\\' && i+1 == len(segment) && separated:
+			b.WriteString(`\\`)
+		case c == '\\' && i+1 < len(segment) && (segment[i+1] == '|' || segment[i+1] == '\\'):
+			b.WriteString(`\\`)
+		default:
+			b.WriteByte(c)
+		}
+	}
+	return b.String()
+}
+
+// NewMetricPath builds a path from unescaped segments
+func NewMetricPath(segments ...string) MetricPath {
+	return MetricPath{Segments: append([]string(nil), segments...)}
+}
+
+// String returns the metric path with "|" in segments escaped
+func (p MetricPath) String() string {
+	escaped := make([]string, len(p.Segments))
+	for i, segment := range p.Segments {
+		escaped[i] = escapeMetricPathSegment(segment, i+1 < len(p.Segments))
+	}
+	return strings.Join(escaped, "|")
+}
+
+// Name returns the last segment of the path
+func (p MetricPath) Name() string {
+	if len(p.Segments) == 0 {
+		return ""
+	}
+	return p.Segments[len(p.Segments)-1]
+}
+
+// Append returns a new path with a metric path appended, metric is parsed as an escaped path
+func (p MetricPath) Append(metric string) MetricPath {
+	segments := append([]string(nil), p.Segments...)
+	if metric != "" {
+		segments = append(segments, ParseMetricPath(metric).Segments...)
+	}
+	return MetricPath{Segments: segments}
+}
+
+// Match reports whether the path matches a pattern using the controller's wildcard semantics.
+// The pattern must have the same number of segments, "*" matches any text within a segment.
+func (p MetricPath) Match(pattern string) bool {
+	patternPath := ParseMetricPath(pattern)
+	if len(patternPath.Segments) != len(p.Segments) {
+		return false
+	}
+	for i, segment := range patternPath.Segments {
+		if !matchMetricSegment(segment, p.Segments[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// matchMetricSegment matches a single segment, only "*" is special
+func matchMetricSegment(pattern string, segment string) bool {
+	if pattern == MetricPathWildcard {
+		return true
+	}
+	parts := strings.Split(pattern, MetricPathWildcard)
+	if len(parts) == 1 {
+		return pattern == segment
+	}
+	if !strings.HasPrefix(segment, parts[0]) {
+		return false
+	}
+	segment = segment[len(parts[0]):]
+	last := parts[len(parts)-1]
+	for _, part := range parts[1 : len(parts)-1] {
+		i := strings.Index(segment, part)
+		if i < 0 {
+			return false
+		}
+		segment = segment[i+len(part):]
+	}
+	return len(segment) >= len(last) && strings.HasSuffix(segment, last)
+}
+
+// Entities extracts entity names from a known metric path template
+func (p MetricPath) Entities() MetricPathEntities {
+	s := p.Segments
+	entities := MetricPathEntities{Kind: MetricPathUnknown, Metric: p.String()}
+	if len(s) < 2 {
+		return entities
+	}
+
+	switch s[0] {
+	case MetricTreeBusinessTransactionPerformance:
+		if len(s) >= 5 && s[1] == MetricTreeBusinessTransactions {
+			entities = MetricPathEntities{Kind: MetricPathBusinessTransaction, Tier: s[2], BusinessTransaction: s[3]}
+			rest := s[4:]
+			if len(rest) >= 3 && rest[0] == MetricTreeIndividualNodes {
+				entities.Node = rest[1]
+				rest = rest[2:]
+			}
+			entities.Metric = NewMetricPath(rest...).String()
+		}
+
+	case MetricTreeOverallApplicationPerformance:
+		if len(s) == 2 {
+			return MetricPathEntities{Kind: MetricPathApplication, Metric: NewMetricPath(s[1]).String()}
+		}
+		entities = MetricPathEntities{Kind: MetricPathTier, Tier: s[1]}
+		rest := s[2:]
+		if len(rest) >= 3 && rest[0] == MetricTreeIndividualNodes {
+			entities.Kind = MetricPathNode
+			entities.Node = rest[1]
+			rest = rest[2:]
+		}
+		entities.Metric = NewMetricPath(rest...).String()
+
+	case MetricTreeBackends:
+		if len(s) >= 3 {
+			entities = MetricPathEntities{Kind: MetricPathBackend, Backend: s[1], Metric: NewMetricPath(s[2:]...).String()}
+		}
+
+	case MetricTreeApplicationInfrastructure:
+		if len(s) < 3 {
+			return entities
+		}
+		entities = MetricPathEntities{Kind: MetricPathInfrastructure, Tier: s[1]}
+		rest := s[2:]
+		if len(rest) >= 3 && rest[0] == MetricTreeIndividualNodes {
+			entities.Node = rest[1]
+			rest = rest[2:]
+		}
+		switch rest[0] {
+		case MetricTreeJVM:
+			entities.Kind = MetricPathJVM
+		case MetricTreeCLR:
+			entities.Kind = MetricPathCLR
+		case MetricTreeHardwareResources:
+			entities.Kind = MetricPathHardware
+		case MetricTreeCustomMetrics:
+			entities.Kind = MetricPathCustom
+		case MetricTreeAgent:
+			entities.Kind = MetricPathAgent
+		}
+		if entities.Kind != MetricPathInfrastructure && entities.Kind != MetricPathAgent && len(rest) > 1 {
+			rest = rest[1:]
+		}
+		entities.Metric = NewMetricPath(rest...).String()
+
+	case MetricTreeCustomMetrics:
+		entities = MetricPathEntities{Kind: MetricPathCustom, Metric: NewMetricPath(s[1:]...).String()}
+	}
+
+	return entities
+}
+
+// Path builds the metric path for the entities, it is the inverse of MetricPath.Entities
+func (e MetricPathEntities) Path() MetricPath {
+	switch e.Kind {
+	case MetricPathApplication:
+		return ApplicationMetricPath(e.Metric)
+	case MetricPathBusinessTransaction:
+		if e.Node != "" {
+			return BusinessTransactionNodeMetricPath(e.Tier, e.BusinessTransaction, e.Node, e.Metric)
+		}
+		return BusinessTransactionMetricPath(e.Tier, e.BusinessTransaction, e.Metric)
+	case MetricPathTier:
+		return TierMetricPath(e.Tier, e.Metric)
+	case MetricPathNode:
+		return NodeMetricPath(e.Tier, e.Node, e.Metric)
+	case MetricPathBackend:
+		return BackendMetricPath(e.Backend, e.Metric)
+	case MetricPathJVM:
+		return JVMMetricPath(e.Tier, e.Node, e.Metric)
+	case MetricPathCLR:
+		return CLRMetricPath(e.Tier, e.Node, e.Metric)
+	case MetricPathHardware:
+		return HardwareMetricPath(e.Tier, e.Node, e.Metric)
+	case MetricPathCustom:
+		if e.Tier == "" {
+			return NewMetricPath(MetricTreeCustomMetrics).Append(e.Metric)
+		}
+		return CustomMetricPath(e.Tier, e.Node, e.Metric)
+	case MetricPathAgent, MetricPathInfrastructure:
+		return infrastructureMetricPath(e.Tier, e.Node, "").Append(e.Metric)
+	}
+	return ParseMetricPath(e.Metric)
+}
+
+// ApplicationMetricPath builds "Overall Application Performance|metric"
+func ApplicationMetricPath(metric string) MetricPath {
+	return NewMetricPath(MetricTreeOverallApplicationPerformance).Append(metric)
+}
+
+// BusinessTransactionMetricPath builds "Business Transaction Performance|Business Transactions|tier|bt|metric"
+func BusinessTransactionMetricPath(tier string, bt string, metric string) MetricPath {
+	return NewMetricPath(MetricTreeBusinessTransactionPerformance, MetricTreeBusinessTransactions, tier, bt).Append(metric)
+}
+
+// BusinessTransactionNodeMetricPath builds the BT metric path for a single node
+func BusinessTransactionNodeMetricPath(tier string, bt string, node string, metric string) MetricPath {
+	return NewMetricPath(MetricTreeBusinessTransactionPerformance, MetricTreeBusinessTransactions, tier, bt, MetricTreeIndividualNodes, node).Append(metric)
+}
+
+// TierMetricPath builds "Overall Application Performance|tier|metric"
+func TierMetricPath(tier string, metric string) MetricPath {
+	return NewMetricPath(MetricTreeOverallApplicationPerformance, tier).Append(metric)
+}
+
+// NodeMetricPath builds "Overall Application Performance|tier|Individual Nodes|node|metric"
+func NodeMetricPath(tier string, node string, metric string) MetricPath {
+	return NewMetricPath(MetricTreeOverallApplicationPerformance, tier, MetricTreeIndividualNodes, node).Append(metric)
+}
+
+// BackendMetricPath builds "Backends|backend|metric"
+func BackendMetricPath(backend string, metric string) MetricPath {
+	return NewMetricPath(MetricTreeBackends, backend).Append(metric)
+}
+
+// JVMMetricPath builds a JVM metric path for a tier, or for a node when node is not empty
+func JVMMetricPath(tier string, node string, metric string) MetricPath {
+	return infrastructureMetricPath(tier, node, MetricTreeJVM).Append(metric)
+}
+
+// CLRMetricPath builds a CLR metric path for a tier, or for a node when node is not empty
+func CLRMetricPath(tier string, node string, metric string) MetricPath {
+	return infrastructureMetricPath(tier, node, MetricTreeCLR).Append(metric)
+}
+
+// HardwareMetricPath builds a hardware metric path for a tier, or for a node when node is not empty
+func HardwareMetricPath(tier string, node string, metric string) MetricPath {
+	return infrastructureMetricPath(tier, node, MetricTreeHardwareResources).Append(metric)
+}
+
+// CustomMetricPath builds a custom metric path for a tier, or for a node when node is not empty
+func CustomMetricPath(tier string, node string, metric string) MetricPath {
+	return infrastructureMetricPath(tier, node, MetricTreeCustomMetrics).Append(metric)
+}
+
+// AgentAvailabilityMetricPath builds the app agent availability path for a node
+func AgentAvailabilityMetricPath(tier string, node string) MetricPath {
+	return infrastructureMetricPath(tier, node, "").Append(MetricAgentAvailability)
+}
+
+// MachineAvailabilityMetricPath builds the machine agent availability path for a node
+func MachineAvailabilityMetricPath(tier string, node string) MetricPath {
+	return infrastructureMetricPath(tier, node, "").Append(MetricMachineAvailability)
+}
+
+func infrastructureMetricPath(tier string, node string, tree string) MetricPath {
+	p := NewMetricPath(MetricTreeApplicationInfrastructure, tier)
+	if node != "" {
+		p.Segments = append(p.Segments, MetricTreeIndividualNodes, node)
+	}
+	if tree != "" {
+		p.Segments = append(p.Segments, tree)
+	}
+	return p
+}
+
+// Path parses the MetricPath of the metric data
+func (m *MetricData) Path() MetricPath {
+	return ParseMetricPath(m.MetricPath)
+}
@@ -0,0 +1,102 @@
+/*
+MIT License
+
+Copyright (c) 2026 Cisco Systems, Inc. and its affiliates
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+
+*/
+
+package appdrest
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseMetricPath(t *testing.T) {
+	tests := []struct {
+		path     string
+		segments []string
+	}{
+		{"Overall Application Performance|Calls per Minute", []string{"Overall Application Performance", "Calls per Minute"}},
+		{`Backends|Discovered backend call - a\|b|Calls per Minute`, []string{"Backends", "Discovered backend call - a|b", "Calls per Minute"}},
+		{`Custom Metrics|C:\\temp\\|Free`, []string{"Custom Metrics", `C:\temp\`, "Free"}},
+		{`Custom Metrics|C:\temp|Free`, []string{"Custom Metrics", `C:\temp`, "Free"}}, // a lone backslash is literal
+		{`a\\|b`, []string{`a\`, "b"}},
+		{"a||b", []string{"a", "", "b"}},
+		{"a|b\\", []string{"a", `b\`}},
+		{"", []string{""}},
+	}
+	for _, test := range tests {
+		got := ParseMetricPath(test.path)
+		if !reflect.DeepEqual(got.Segments, test.segments) {
+			t.Errorf("ParseMetricPath(%q) = %q, want %q", test.path, got.Segments, test.segments)
+		}
+		if again := ParseMetricPath(got.String()); !reflect.DeepEqual(again.Segments, test.segments) {
+			t.Errorf("ParseMetricPath(%q).String() = %q does not round trip", test.path, got.String())
+		}
+	}
+}
+
+func TestMetricPathStringRoundTrip(t *testing.T) {
+	for _, path := range []string{
+		"a|b\\",
+		`Custom Metrics|C:\temp|Free`,
+		`Custom Metrics|C:\temp\\|Free`,
+		`Backends|a\|b|Calls per Minute`,
+		`a\\\|b`,
+	} {
+		if got := ParseMetricPath(path).String(); got != path {
+			t.Errorf("ParseMetricPath(%q).String() = %q", path, got)
+		}
+	}
+}
+
+func TestEscapeMetricPathSegment(t *testing.T) {
+	for segment, want := range map[string]string{
+		"a|b":       `a\|b`,
+		`C:\temp`:   `C:\temp`,
+		`C:\temp\`:  `C:\temp\`, // a trailing backslash is literal
+		`a\|b`:      `a\\\|b`,
+		"plain BT ": "plain BT ",
+	} {
+		if got := EscapeMetricPathSegment(segment); got != want {
+			t.Errorf("EscapeMetricPathSegment(%q) = %q, want %q", segment, got, want)
+		}
+		if got := ParseMetricPath(EscapeMetricPathSegment(segment)).Segments; len(got) != 1 || got[0] != segment {
+			t.Errorf("escaped %q parses as %q", segment, got)
+		}
+	}
+}
+
+func TestMetricPathEntities(t *testing.T) {
+	path := BusinessTransactionNodeMetricPath("web|eu", "/pay|card", "node-1", "Calls per Minute")
+	entities := ParseMetricPath(path.String()).Entities()
+	want := MetricPathEntities{Kind: MetricPathBusinessTransaction, Tier: "web|eu", BusinessTransaction: "/pay|card", Node: "node-1", Metric: "Calls per Minute"}
+	if entities != want {
+		t.Errorf("entities %+v, want %+v", entities, want)
+	}
+	if got := entities.Path().String(); got != path.String() {
+		t.Errorf("Path() = %q, want %q", got, path.String())
+	}
+	if !path.Match(`Business Transaction Performance|Business Transactions|web\|*|*|Individual Nodes|*|Calls per Minute`) {
+		t.Errorf("%q does not match an escaped wildcard pattern", path)
+	}
+}
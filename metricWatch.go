@@ -0,0 +1,222 @@
+/*
+MIT License
+
+Copyright (c) 2026 Cisco Systems, Inc. and its affiliates
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+
+*/
+
+package appdrest
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// Defaults for MetricWatcher
+const (
+	DefaultWatchLookback    = 10 * time.Minute
+	DefaultWatchSettle      = time.Minute
+	DefaultWatchMaxFailures = 5
+)
+
+// MetricPoint is a single minute bucket delivered by a MetricWatcher
+type MetricPoint struct {
+	MetricName string
+	MetricID   int
+	MetricPath string
+	Value      MetricValue
+	Late       bool // the bucket is older than a bucket already delivered for the same metric
+}
+
+// Time returns the start time of the bucket
+func (p MetricPoint) Time() time.Time {
+	return time.UnixMilli(p.Value.StartTimeInMillis)
+}
+
+// MetricWatcher polls metric-data with BEFORE_NOW windows and delivers every minute bucket once.
+// Each poll requests Lookback minutes so buckets the controller reports late are backfilled,
+// buckets younger than Settle after their minute ended are held back until the controller finished aggregating them.
+// When the last successful poll is longer ago than the lookback allows, e.g. after failed polls or with an
+// Interval above Lookback-Settle, the window is extended back to it so no bucket is skipped.
+// Windows of several hours may be answered with coarser buckets by the controller.
+type MetricWatcher struct {
+	Service     *MetricDataService
+	Query       MetricQuery
+	Interval    time.Duration
+	Lookback    time.Duration // defaults to DefaultWatchLookback
+	Settle      time.Duration // defaults to DefaultWatchSettle
+	MaxFailures int           // consecutive failed polls before Run returns, defaults to DefaultWatchMaxFailures
+
+	seen     map[string]map[int64]bool
+	newest   map[string]int64
+	lastPoll time.Time // time of the last successful poll
+}
+
+// Watch polls the query every interval and calls fn with the new points until ctx is done or fn returns an error
+// Added 2026 Cisco Systems, Inc.
+func (s *MetricDataService) Watch(ctx context.Context, query MetricQuery, interval time.Duration, fn func([]MetricPoint) error) error {
+	w := &MetricWatcher{Service: s, Query: query, Interval: interval}
+	return w.Run(ctx, fn)
+}
+
+// WatchChannel is Watch delivering points through a channel.
+// Both channels are closed when watching stops, the error channel receives the reason unless ctx was cancelled.
+// Added 2026 Cisco Systems, Inc.
+func (s *MetricDataService) WatchChannel(ctx context.Context, query MetricQuery, interval time.Duration) (<-chan MetricPoint, <-chan error) {
+	points := make(chan MetricPoint)
+	errs := make(chan error, 1)
+
+	go func() {
+		defer close(points)
+		defer close(errs)
+
+		err := s.Watch(ctx, query, interval, func(batch []MetricPoint) error {
+			for _, p := range batch {
+				select {
+				case points <- p:
+				case <-ctx.Done():
+					return ctx.Err()
+				}
+			}
+			return nil
+		})
+		if err != nil && ctx.Err() == nil {
+			errs <- err
+		}
+	}()
+
+	return points, errs
+}
+
+// Run polls until ctx is done, fn returns an error or MaxFailures consecutive polls failed
+func (w *MetricWatcher) Run(ctx context.Context, fn func([]MetricPoint) error) error {
+	if w.Interval <= 0 {
+		return fmt.Errorf("Metric watch: interval must be positive, got %v", w.Interval)
+	}
+	maxFailures := w.MaxFailures
+	if maxFailures <= 0 {
+		maxFailures = DefaultWatchMaxFailures
+	}
+
+	ticker := time.NewTicker(w.Interval)
+	defer ticker.Stop()
+
+	failures := 0
+	for {
+		points, err := w.Poll(time.Now())
+		if err != nil {
+			failures++
+			w.Service.client.log.Warningf("Metric watch %s: poll %d of %d failed: %v", w.Query.MetricPath, failures, maxFailures, err)
+			if failures >= maxFailures {
+				return err
+			}
+		} else {
+			failures = 0
+			if len(points) > 0 {
+				err = fn(points)
+				if err != nil {
+					return err
+				}
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// Poll fetches the lookback window, extended back to the last successful poll, and returns the settled buckets not delivered before
+func (w *MetricWatcher) Poll(now time.Time) ([]MetricPoint, error) {
+	lookback := w.Lookback
+	if lookback <= 0 {
+		lookback = DefaultWatchLookback
+	}
+	settle := w.Settle
+	if settle <= 0 {
+		settle = DefaultWatchSettle
+	}
+	if w.seen == nil {
+		w.seen = make(map[string]map[int64]bool)
+		w.newest = make(map[string]int64)
+	}
+
+	// buckets up to lastPoll-settle-1m were delivered, the window has to reach back to them
+	window := lookback
+	if !w.lastPoll.IsZero() {
+		if since := now.Sub(w.lastPoll) + settle + time.Minute; since > window {
+			window = since
+		}
+	}
+
+	minutes := int((window + time.Minute - 1) / time.Minute)
+	metrics, err := w.Service.GetMetricData(w.Query.Application, w.Query.MetricPath, w.Query.Rollup, TimeBEFORENOW, minutes, time.Time{}, time.Time{})
+	if err != nil {
+		return nil, err
+	}
+	w.lastPoll = now
+
+	settledBefore := now.Add(-settle - time.Minute).UnixMilli()
+	expireBefore := now.Add(-window - settle - time.Minute).UnixMilli()
+
+	var points []MetricPoint
+	for _, metric := range metrics {
+		seen := w.seen[metric.MetricPath]
+		if seen == nil {
+			seen = make(map[int64]bool)
+			w.seen[metric.MetricPath] = seen
+		}
+
+		values := append([]MetricValue(nil), metric.MetricValues...)
+		sort.Slice(values, func(i, j int) bool { return values[i].StartTimeInMillis < values[j].StartTimeInMillis })
+
+		for _, value := range values {
+			bucket := value.StartTimeInMillis
+			if seen[bucket] || bucket > settledBefore {
+				continue
+			}
+			seen[bucket] = true
+			points = append(points, MetricPoint{
+				MetricName: metric.MetricName,
+				MetricID:   metric.MetricID,
+				MetricPath: metric.MetricPath,
+				Value:      value,
+				Late:       bucket < w.newest[metric.MetricPath],
+			})
+			if bucket > w.newest[metric.MetricPath] {
+				w.newest[metric.MetricPath] = bucket
+			}
+		}
+
+		// buckets outside of the window can never be returned again
+		for bucket := range seen {
+			if bucket < expireBefore {
+				delete(seen, bucket)
+			}
+		}
+	}
+
+	return points, nil
+}
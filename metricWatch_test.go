@@ -0,0 +1,119 @@
+/*
+MIT License
+
+Copyright (c) 2026 Cisco Systems, Inc. and its affiliates
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+
+*/
+
+package appdrest
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+)
+
+// watchController serves one metric with a bucket per minute of the BEFORE_NOW window ending at now,
+// except for the minutes in missing
+type watchController struct {
+	t       *testing.T
+	now     time.Time
+	fail    bool
+	missing map[time.Time]bool
+	minutes []int // duration-in-mins of every request
+}
+
+func (c *watchController) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if c.fail {
+		http.Error(w, "unavailable", http.StatusServiceUnavailable)
+		return
+	}
+	minutes, err := strconv.Atoi(r.URL.Query().Get("duration-in-mins"))
+	if err != nil {
+		c.t.Fatalf("request without duration: %s", r.URL)
+	}
+	c.minutes = append(c.minutes, minutes)
+
+	metric := MetricData{MetricName: "Calls per Minute", MetricPath: "Overall Application Performance|Calls per Minute"}
+	for bucket := c.now.Add(-time.Duration(minutes) * time.Minute); bucket.Before(c.now); bucket = bucket.Add(time.Minute) {
+		if !c.missing[bucket] {
+			metric.MetricValues = append(metric.MetricValues, MetricValue{StartTimeInMillis: bucket.UnixMilli(), Value: bucket.Minute()})
+		}
+	}
+	json.NewEncoder(w).Encode([]MetricData{metric})
+}
+
+func TestMetricWatcherPoll(t *testing.T) {
+	start := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	controller := &watchController{t: t, now: start, missing: map[time.Time]bool{start.Add(-5 * time.Minute): true}}
+	client := newTestClient(t, controller)
+	w := &MetricWatcher{Service: client.MetricData, Query: MetricQuery{Application: "shop", MetricPath: "Overall Application Performance|Calls per Minute"}, Interval: time.Minute}
+
+	delivered := make(map[int64]int)
+	poll := func(now time.Time) []MetricPoint {
+		t.Helper()
+		controller.now = now
+		points, err := w.Poll(now)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, p := range points {
+			delivered[p.Value.StartTimeInMillis]++
+		}
+		return points
+	}
+
+	// settled are the buckets up to two minutes before now, the late one is missing
+	if points := poll(start); len(points) != 8 {
+		t.Fatalf("first poll delivered %d points, want 8", len(points))
+	}
+
+	// the late bucket is backfilled and flagged, delivered buckets are not repeated
+	controller.missing = nil
+	points := poll(start.Add(time.Minute))
+	if len(points) != 2 {
+		t.Fatalf("second poll delivered %d points, want 2", len(points))
+	}
+	if !points[0].Late || !points[0].Time().Equal(start.Add(-5*time.Minute)) || points[1].Late {
+		t.Errorf("second poll delivered %+v", points)
+	}
+
+	// failed polls for half an hour, the next successful poll reaches back to the last one
+	controller.fail = true
+	if _, err := w.Poll(start.Add(2 * time.Minute)); err == nil {
+		t.Fatal("poll against failing controller succeeded")
+	}
+	controller.fail = false
+	if points := poll(start.Add(31 * time.Minute)); len(points) != 30 {
+		t.Fatalf("poll after failures delivered %d points, want 30", len(points))
+	}
+	if last := controller.minutes[len(controller.minutes)-1]; last != 32 {
+		t.Errorf("poll after failures requested %d minutes, want 32", last)
+	}
+
+	for bucket := start.Add(-10 * time.Minute); !bucket.After(start.Add(29 * time.Minute)); bucket = bucket.Add(time.Minute) {
+		if n := delivered[bucket.UnixMilli()]; n != 1 {
+			t.Errorf("bucket %s delivered %d times", bucket.Format("15:04"), n)
+		}
+	}
+}
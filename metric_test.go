@@ -0,0 +1,69 @@
+/*
+MIT License
+
+Copyright (c) 2026 Cisco Systems, Inc. and its affiliates
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+
+*/
+
+package appdrest
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestGetMetricDataChunkedUnaligned(t *testing.T) {
+	// serves a bucket per minute that overlaps the requested range
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		startMillis, _ := strconv.ParseInt(r.URL.Query().Get("start-time"), 10, 64)
+		endMillis, _ := strconv.ParseInt(r.URL.Query().Get("end-time"), 10, 64)
+		metric := MetricData{MetricName: "Calls per Minute"}
+		for bucket := time.UnixMilli(startMillis).Truncate(time.Minute); bucket.Before(time.UnixMilli(endMillis)); bucket = bucket.Add(time.Minute) {
+			metric.MetricValues = append(metric.MetricValues, MetricValue{StartTimeInMillis: bucket.UnixMilli(), Value: bucket.Minute()})
+		}
+		json.NewEncoder(w).Encode([]MetricData{metric})
+	}))
+
+	start := time.Date(2026, 10, 19, 12, 0, 30, 0, time.UTC)
+	var got []int
+	err := client.MetricData.GetMetricDataChunked("shop", "Overall Application Performance|Calls per Minute", false, start, start.Add(5*time.Minute), 90*time.Second, func(metrics []*MetricData) error {
+		for _, value := range metrics[0].MetricValues {
+			got = append(got, value.Value)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []int{0, 1, 2, 3, 4, 5}
+	if len(got) != len(want) {
+		t.Fatalf("buckets %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("buckets %v, want %v", got, want)
+			break
+		}
+	}
+}
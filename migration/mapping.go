@@ -0,0 +1,320 @@
+/*
+MIT License
+
+Copyright (c) 2026 Cisco Systems, Inc. and its affiliates
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+
+*/
+
+package migration
+
+import (
+	"sort"
+
+	appdrest "github.com/cisco-open/appd-client-go"
+)
+
+// EntityKind is the kind of entity a configuration object refers to by name
+type EntityKind string
+
+// Consts for the entity kinds that are remapped
+const (
+	KindApplication         EntityKind = "APPLICATION"
+	KindTier                EntityKind = "TIER"
+	KindBusinessTransaction EntityKind = "BUSINESS_TRANSACTION"
+	KindBackend             EntityKind = "BACKEND"
+	KindNode                EntityKind = "NODE"
+	KindHealthRule          EntityKind = "HEALTH_RULE"
+	KindAction              EntityKind = "ACTION"
+)
+
+// Mapping renames entities from the source to the target controller.
+// Names without an entry are kept as they are.
+type Mapping struct {
+	Applications         map[string]string
+	Tiers                map[string]string
+	BusinessTransactions map[string]string
+	Backends             map[string]string
+	Nodes                map[string]string
+}
+
+// Name returns the target name of an entity
+func (m Mapping) Name(kind EntityKind, name string) string {
+	var names map[string]string
+	switch kind {
+	case KindApplication:
+		names = m.Applications
+	case KindTier:
+		names = m.Tiers
+	case KindBusinessTransaction:
+		names = m.BusinessTransactions
+	case KindBackend:
+		names = m.Backends
+	case KindNode:
+		names = m.Nodes
+	}
+	if mapped, ok := names[name]; ok {
+		return mapped
+	}
+	return name
+}
+
+// Reference is a named entity used by a migrated object
+type Reference struct {
+	Kind       EntityKind `json:"kind"`
+	Name       string     `json:"name"`       // name on the target controller
+	SourceName string     `json:"sourceName"` // name on the source controller
+	Object     string     `json:"object"`     // object holding the reference, e.g. "health-rule Slow checkout"
+}
+
+// remapper rewrites entity names of one object and records the references
+type remapper struct {
+	mapping    Mapping
+	object     string
+	references []Reference
+}
+
+func (r *remapper) name(kind EntityKind, name string) string {
+	if name == "" {
+		return name
+	}
+	mapped := r.mapping.Name(kind, name)
+	r.references = append(r.references, Reference{Kind: kind, Name: mapped, SourceName: name, Object: r.object})
+	return mapped
+}
+
+func (r *remapper) pointer(kind EntityKind, name *string) {
+	if name != nil {
+		*name = r.name(kind, *name)
+	}
+}
+
+// entityKind translates the entity types used by health rules and dashboards
+func entityKind(entityType string) (EntityKind, bool) {
+	switch entityType {
+	case "APPLICATION":
+		return KindApplication, true
+	case "TIER", "APPLICATION_COMPONENT", "TIER_AFFECTED_ENTITY":
+		return KindTier, true
+	case "BUSINESS_TRANSACTION", "BUSINESS_TRANSACTION_PERFORMANCE":
+		return KindBusinessTransaction, true
+	case "BACKEND", "BACKEND_CALL", "BACKENDS":
+		return KindBackend, true
+	case "NODE", "APPLICATION_COMPONENT_NODE":
+		return KindNode, true
+	}
+	return "", false
+}
+
+// typed remaps name when entityType is a known entity type
+func (r *remapper) typed(entityType *string, name *string) {
+	if entityType == nil {
+		return
+	}
+	if kind, ok := entityKind(*entityType); ok {
+		r.pointer(kind, name)
+	}
+}
+
+// metricPath remaps the entity names of a full metric path, relative paths are kept
+func (r *remapper) metricPath(metricPath *string) {
+	if metricPath == nil || *metricPath == "" {
+		return
+	}
+	entities := appdrest.ParseMetricPath(*metricPath).Entities()
+	if entities.Kind == appdrest.MetricPathUnknown || entities.Kind == appdrest.MetricPathApplication {
+		return
+	}
+	if entities.Tier != "" {
+		entities.Tier = r.name(KindTier, entities.Tier)
+	}
+	if entities.BusinessTransaction != "" {
+		entities.BusinessTransaction = r.name(KindBusinessTransaction, entities.BusinessTransaction)
+	}
+	if entities.Node != "" {
+		entities.Node = r.name(KindNode, entities.Node)
+	}
+	if entities.Backend != "" {
+		entities.Backend = r.name(KindBackend, entities.Backend)
+	}
+	*metricPath = entities.Path().String()
+}
+
+// healthRuleKeys are the keys of a health rule holding entity names
+var healthRuleKeys = map[string]EntityKind{
+	"applicationName":         KindApplication,
+	"tierName":                KindTier,
+	"tiers":                   KindTier,
+	"affectedTiers":           KindTier,
+	"specificTiers":           KindTier,
+	"businessTransactionName": KindBusinessTransaction,
+	"businessTransactions":    KindBusinessTransaction,
+	"backendName":             KindBackend,
+	"backends":                KindBackend,
+	"nodeName":                KindNode,
+	"nodes":                   KindNode,
+	"specificNodes":           KindNode,
+}
+
+// healthRule remaps the affected entities and metric paths of a health rule in place.
+// The rule is walked as generic JSON so fields unknown to this package are copied unchanged.
+func (r *remapper) healthRule(rule appdrest.RawHealthRule) {
+	r.walk(map[string]interface{}(rule), healthRuleKeys, "")
+}
+
+// transactionDetection remaps the tiers of the scopes of custom transaction detection rules
+func (r *remapper) transactionDetection(rules *appdrest.MdsData) {
+	for i := range rules.ScopeList.Scope {
+		tiers := rules.ScopeList.Scope[i].IncludedTiers
+		for j := range tiers {
+			tiers[j] = r.name(KindTier, tiers[j])
+		}
+	}
+}
+
+// dashboard remaps the associated entities and the widget data series of a dashboard
+func (r *remapper) dashboard(dashboard *appdrest.DashboardExport) {
+	for _, template := range dashboard.AssociatedEntityTemplates {
+		if template == nil {
+			continue
+		}
+		r.pointer(KindApplication, template.ApplicationName)
+		r.typed(template.EntityType, template.EntityName)
+		r.typed(template.ScopingEntityType, template.ScopingEntityName)
+	}
+	for i := range dashboard.WidgetTemplates {
+		for _, series := range dashboard.WidgetTemplates[i].DataSeriesTemplates {
+			if series == nil {
+				continue
+			}
+			criteria := &series.MetricMatchCriteriaTemplate
+			r.pointer(KindApplication, criteria.ApplicationName)
+			expression := &criteria.MetricExpressionTemplate
+			r.metricPath(expression.MetricPath)
+			r.metricPath(expression.InputMetricPath)
+			scope := &expression.ScopeEntity
+			r.pointer(KindApplication, scope.ApplicationName)
+			r.typed(scope.EntityType, scope.EntityName)
+			r.typed(scope.ScopingEntityType, scope.ScopingEntityName)
+		}
+	}
+}
+
+// policyKeys are the keys of a policy entity selection holding entity names
+var policyKeys = map[string]EntityKind{
+	"applicationName":          KindApplication,
+	"applicationComponentName": KindTier,
+	"tierName":                 KindTier,
+	"tiers":                    KindTier,
+	"businessTransactionName":  KindBusinessTransaction,
+	"businessTransactions":     KindBusinessTransaction,
+	"backendName":              KindBackend,
+	"backends":                 KindBackend,
+	"nodeName":                 KindNode,
+	"nodes":                    KindNode,
+}
+
+// policy remaps the referenced health rules, actions and the selected entities of a policy
+func (r *remapper) policy(policy *appdrest.PolicyDetail) {
+	for _, action := range policy.Actions {
+		if action != nil && action.ActionName != nil {
+			r.references = append(r.references, Reference{Kind: KindAction, Name: *action.ActionName, SourceName: *action.ActionName, Object: r.object})
+		}
+	}
+	if policy.Events != nil && policy.Events.HealthRuleEvents != nil && policy.Events.HealthRuleEvents.HealthRuleScope != nil {
+		for _, name := range policy.Events.HealthRuleEvents.HealthRuleScope.HealthRules {
+			r.references = append(r.references, Reference{Kind: KindHealthRule, Name: name, SourceName: name, Object: r.object})
+		}
+	}
+	r.generic(policy.SelectedEntities)
+}
+
+// generic walks generic JSON and remaps strings stored under policyKeys
+func (r *remapper) generic(v interface{}) {
+	r.walk(v, policyKeys, "")
+}
+
+// walk remaps strings stored under keys in generic JSON. Metric paths are remapped wherever they occur,
+// "affectedEntityName" is remapped by the closest "entityType" or "affectedEntityType".
+func (r *remapper) walk(v interface{}, keys map[string]EntityKind, entityType string) {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for _, key := range []string{"affectedEntityType", "entityType"} {
+			if t, ok := v[key].(string); ok {
+				entityType = t
+			}
+		}
+		names := make([]string, 0, len(v))
+		for key := range v {
+			names = append(names, key)
+		}
+		sort.Strings(names)
+		for _, key := range names {
+			switch value := v[key].(type) {
+			case string:
+				switch {
+				case key == "metricPath":
+					r.metricPath(&value)
+					v[key] = value
+				case key == "affectedEntityName":
+					r.typed(&entityType, &value)
+					v[key] = value
+				default:
+					if kind, ok := keys[key]; ok {
+						v[key] = r.name(kind, value)
+					}
+				}
+			case []interface{}:
+				kind, ok := keys[key]
+				for i, item := range value {
+					if name, isString := item.(string); isString && ok {
+						value[i] = r.name(kind, name)
+					} else {
+						r.walk(item, keys, entityType)
+					}
+				}
+			default:
+				r.walk(value, keys, entityType)
+			}
+		}
+	case []interface{}:
+		for _, item := range v {
+			r.walk(item, keys, entityType)
+		}
+	}
+}
+
+// remapConfig renames tiers and business transactions of an application config and all references to them.
+// Every name is mapped from its source value, never from an already renamed one,
+// so swaps like {a: b, b: a} and chains like {a: b, b: c} come out right.
+func remapConfig(config *appdrest.ApplicationConfig, mapping Mapping) {
+	for i := range config.Tiers {
+		config.Tiers[i].Name = mapping.Name(KindTier, config.Tiers[i].Name)
+	}
+	for i := range config.BusinessTransactions {
+		bt := &config.BusinessTransactions[i]
+		bt.Tier = mapping.Name(KindTier, bt.Tier)
+		bt.Name = mapping.Name(KindBusinessTransaction, bt.Name)
+	}
+	for i := range config.CustomMatchPoints {
+		matchPoint := &config.CustomMatchPoints[i]
+		matchPoint.BusinessTransaction = mapping.Name(KindBusinessTransaction, matchPoint.BusinessTransaction)
+	}
+}
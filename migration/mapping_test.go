@@ -0,0 +1,143 @@
+/*
+MIT License
+
+Copyright (c) 2026 Cisco Systems, Inc. and its affiliates
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+
+*/
+
+package migration
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"reflect"
+	"testing"
+
+	appdrest "github.com/cisco-open/appd-client-go"
+)
+
+var testMapping = Mapping{
+	Tiers:                map[string]string{"web": "web-eu"},
+	BusinessTransactions: map[string]string{"/checkout": "Checkout"},
+	Nodes:                map[string]string{"web-1": "web-eu-1"},
+}
+
+func TestRemapRawHealthRule(t *testing.T) {
+	const source = `{
+		"name": "Slow checkout",
+		"scheduleName": "Business Hours",
+		"affects": {
+			"affectedEntityType": "BUSINESS_TRANSACTION_PERFORMANCE",
+			"affectedBusinessTransactions": {"businessTransactionScope": "SPECIFIC_BUSINESS_TRANSACTIONS", "businessTransactions": ["/checkout", "/cart"]}
+		},
+		"evalCriterias": {"criticalCriteria": {"conditions": [{"evalDetail": {
+			"metricPath": "Overall Application Performance|web|Individual Nodes|web-1|Calls per Minute",
+			"metricEvalDetail": {"baselineName": "Daily Trend"}
+		}}]}},
+		"unmodelled": {"nodes": ["web-1"]}
+	}`
+	var rule appdrest.RawHealthRule
+	if err := json.Unmarshal([]byte(source), &rule); err != nil {
+		t.Fatal(err)
+	}
+	r := &remapper{mapping: testMapping, object: "health-rule Slow checkout"}
+	r.healthRule(rule)
+
+	affects := rule["affects"].(map[string]interface{})["affectedBusinessTransactions"].(map[string]interface{})
+	if got := affects["businessTransactions"]; !reflect.DeepEqual(got, []interface{}{"Checkout", "/cart"}) {
+		t.Errorf("business transactions = %v", got)
+	}
+	condition := rule["evalCriterias"].(map[string]interface{})["criticalCriteria"].(map[string]interface{})["conditions"].([]interface{})[0]
+	evalDetail := condition.(map[string]interface{})["evalDetail"].(map[string]interface{})
+	if got, want := evalDetail["metricPath"], "Overall Application Performance|web-eu|Individual Nodes|web-eu-1|Calls per Minute"; got != want {
+		t.Errorf("metric path = %v, want %v", got, want)
+	}
+	if rule["scheduleName"] != "Business Hours" || evalDetail["metricEvalDetail"].(map[string]interface{})["baselineName"] != "Daily Trend" {
+		t.Errorf("unmapped fields changed: %v", rule)
+	}
+	if got := rule["unmodelled"].(map[string]interface{})["nodes"]; !reflect.DeepEqual(got, []interface{}{"web-eu-1"}) {
+		t.Errorf("nodes = %v", got)
+	}
+	if len(r.references) != 5 {
+		t.Errorf("references = %v", r.references)
+	}
+}
+
+func TestRemapTransactionDetection(t *testing.T) {
+	const source = `<mds-data controller-version="004-004-001-000"><scope-list>
+		<scope scope-name="Default Scope" scope-type="ALL_TIERS_IN_APP" scope-version="0"/>
+		<scope scope-name="web only" scope-type="SELECTED_TIERS" scope-version="0"><included-tiers><tier-name>web</tier-name><tier-name>api</tier-name></included-tiers></scope>
+	</scope-list></mds-data>`
+	var rules appdrest.MdsData
+	if err := xml.Unmarshal([]byte(source), &rules); err != nil {
+		t.Fatal(err)
+	}
+	r := &remapper{mapping: testMapping, object: string(TransactionDetection)}
+	r.transactionDetection(&rules)
+
+	if got := rules.ScopeList.Scope[1].IncludedTiers; !reflect.DeepEqual(got, []string{"web-eu", "api"}) {
+		t.Errorf("included tiers = %v", got)
+	}
+	body, err := xml.Marshal(rules)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var back appdrest.MdsData
+	if err := xml.Unmarshal(body, &back); err != nil || !reflect.DeepEqual(back.ScopeList.Scope[1].IncludedTiers, []string{"web-eu", "api"}) {
+		t.Errorf("round trip lost included tiers: %s", body)
+	}
+}
+
+func TestRemapConfigSwap(t *testing.T) {
+	config := &appdrest.ApplicationConfig{
+		Tiers: []appdrest.ConfigTier{{Name: "a"}, {Name: "b"}, {Name: "c"}},
+		BusinessTransactions: []appdrest.ConfigBusinessTransaction{
+			{Name: "/login", Tier: "a"},
+			{Name: "/logout", Tier: "b"},
+			{Name: "/cart", Tier: "c"},
+		},
+		CustomMatchPoints: []appdrest.ConfigCustomMatchPoint{{Name: "login rule", BusinessTransaction: "/login"}},
+	}
+	mapping := Mapping{
+		Tiers:                map[string]string{"a": "b", "b": "a", "c": "d"},
+		BusinessTransactions: map[string]string{"/login": "/logout", "/logout": "/login"},
+	}
+	remapConfig(config, mapping)
+
+	var tiers []string
+	for _, tier := range config.Tiers {
+		tiers = append(tiers, tier.Name)
+	}
+	if !reflect.DeepEqual(tiers, []string{"b", "a", "d"}) {
+		t.Errorf("tiers %v, want [b a d]", tiers)
+	}
+	want := []appdrest.ConfigBusinessTransaction{
+		{Name: "/logout", Tier: "b"},
+		{Name: "/login", Tier: "a"},
+		{Name: "/cart", Tier: "d"},
+	}
+	if !reflect.DeepEqual(config.BusinessTransactions, want) {
+		t.Errorf("business transactions %+v, want %+v", config.BusinessTransactions, want)
+	}
+	if got := config.CustomMatchPoints[0].BusinessTransaction; got != "/logout" {
+		t.Errorf("custom match point refers to %q, want /logout", got)
+	}
+}
@@ -0,0 +1,685 @@
+/*
+MIT License
+
+Copyright (c) 2026 Cisco Systems, Inc. and its affiliates
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+
+*/
+
+// Package migration copies the configuration of an application from one controller to another,
+// e.g. from an on-premises controller to SaaS, renaming applications, tiers, business transactions,
+// backends and nodes on the way. IDs are never copied, every reference is resolved by name on the
+// target controller and references that cannot be resolved are reported.
+package migration
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+
+	appdrest "github.com/cisco-open/appd-client-go"
+)
+
+// ObjectType is the type of a migrated object
+type ObjectType string
+
+// Consts for the object types, they are imported in this order
+const (
+	ApplicationConfig    ObjectType = "application-config"
+	TransactionDetection ObjectType = "transaction-detection"
+	HealthRule           ObjectType = "health-rule"
+	Action               ObjectType = "action"
+	Policy               ObjectType = "policy"
+	Dashboard            ObjectType = "dashboard"
+)
+
+// AllTypes lists every object type in dependency order
+var AllTypes = []ObjectType{ApplicationConfig, TransactionDetection, HealthRule, Action, Policy, Dashboard}
+
+// Consts for the outcome of migrating one object
+const (
+	Created  = "created"
+	Updated  = "updated"
+	Imported = "imported"
+	Skipped  = "skipped"
+	Failed   = "failed"
+)
+
+// ErrUnresolvedReferences is returned in strict mode when objects refer to entities missing on the target
+var ErrUnresolvedReferences = errors.New("unresolved references")
+
+// Options control a migration
+type Options struct {
+	Mapping   Mapping
+	Types     []ObjectType // object types to migrate, empty for all
+	Overwrite bool         // replace objects that already exist on the target
+	DryRun    bool         // only plan and report, nothing is written to the target
+	Strict    bool         // do not write anything when there are unresolved references
+}
+
+func (o Options) wantsType(t ObjectType) bool {
+	if len(o.Types) == 0 {
+		return true
+	}
+	for _, candidate := range o.Types {
+		if candidate == t {
+			return true
+		}
+	}
+	return false
+}
+
+// Step is the outcome for one migrated object
+type Step struct {
+	Type   ObjectType `json:"type"`
+	Name   string     `json:"name"`
+	Action string     `json:"action"`
+	Reason string     `json:"reason,omitempty"`
+}
+
+// Report describes a migration, in dry-run mode it describes what would be done
+type Report struct {
+	SourceApplication string                     `json:"sourceApplication"`
+	TargetApplication string                     `json:"targetApplication"`
+	DryRun            bool                       `json:"dryRun"`
+	Steps             []Step                     `json:"steps"`
+	Unresolved        []Reference                `json:"unresolved"`
+	IDs               map[EntityKind]map[int]int `json:"ids"` // source ID to target ID by entity kind, for reference only
+}
+
+// Failed returns the steps that could not be completed
+func (r *Report) Failed() []Step {
+	var failed []Step
+	for _, step := range r.Steps {
+		if step.Action == Failed {
+			failed = append(failed, step)
+		}
+	}
+	return failed
+}
+
+// WriteText writes the report in human readable form
+func (r *Report) WriteText(w io.Writer) error {
+	mode := ""
+	if r.DryRun {
+		mode = " (dry run)"
+	}
+	_, err := fmt.Fprintf(w, "migrating %s to %s%s\n", r.SourceApplication, r.TargetApplication, mode)
+	if err != nil {
+		return err
+	}
+	for _, step := range r.Steps {
+		line := fmt.Sprintf("  %-9s %s %s", step.Action, step.Type, step.Name)
+		if step.Reason != "" {
+			line += ": " + step.Reason
+		}
+		if _, err := fmt.Fprintln(w, line); err != nil {
+			return err
+		}
+	}
+	for _, ref := range r.Unresolved {
+		if _, err := fmt.Fprintf(w, "  unresolved %s %q used by %s\n", ref.Kind, ref.Name, ref.Object); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Migrator copies application configuration from Source to Target
+type Migrator struct {
+	Source  *appdrest.Client
+	Target  *appdrest.Client
+	Options Options
+}
+
+// New returns a Migrator between two controllers
+func New(source *appdrest.Client, target *appdrest.Client, opts Options) *Migrator {
+	return &Migrator{Source: source, Target: target, Options: opts}
+}
+
+// plan holds the remapped objects of one application
+type plan struct {
+	source      *appdrest.Application
+	targetName  string
+	config      *appdrest.ApplicationConfig
+	detection   *appdrest.MdsData
+	healthRules []appdrest.RawHealthRule
+	actions     []appdrest.ActionDetail
+	policies    []*appdrest.PolicyDetail
+	dashboards  []*appdrest.DashboardExport
+	references  []Reference
+}
+
+// Migrate copies the configuration of the named source application.
+// The target application is created when it does not exist.
+// The returned error is set when the source cannot be read, the target application cannot be
+// created or, with Options.Strict, when references are unresolved, failures of single
+// objects are reported in the Report.
+func (m *Migrator) Migrate(application string) (*Report, error) {
+	p, err := m.export(application)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &Report{
+		SourceApplication: p.source.Name,
+		TargetApplication: p.targetName,
+		DryRun:            m.Options.DryRun,
+		IDs:               make(map[EntityKind]map[int]int),
+	}
+
+	target, err := m.Target.Application.GetApplicationByName(p.targetName)
+	if err != nil && !errors.Is(err, appdrest.ErrApplicationNotFound) {
+		return nil, err
+	}
+	known, err := m.known(target, p)
+	if err != nil {
+		return nil, err
+	}
+	report.Unresolved = unresolved(p.references, known)
+
+	if m.Options.Strict && len(report.Unresolved) > 0 {
+		return report, fmt.Errorf("%w: %d entities missing on the target", ErrUnresolvedReferences, len(report.Unresolved))
+	}
+
+	if target == nil && !m.Options.DryRun {
+		target, err = m.Target.Application.CreateApplication(p.targetName, p.source.Description)
+		if err != nil {
+			return report, fmt.Errorf("creating application %s: %v", p.targetName, err)
+		}
+		report.Steps = append(report.Steps, Step{Type: ApplicationConfig, Name: p.targetName, Action: Created})
+	} else if target == nil {
+		report.Steps = append(report.Steps, Step{Type: ApplicationConfig, Name: p.targetName, Action: Created})
+	}
+
+	m.apply(report, target, p)
+
+	if target != nil {
+		err = m.mapIDs(report, target)
+		if err != nil {
+			return report, err
+		}
+	}
+	return report, nil
+}
+
+// export reads and remaps all objects of the source application
+func (m *Migrator) export(application string) (*plan, error) {
+	source, err := m.Source.Application.GetApplication(application)
+	if err != nil {
+		return nil, fmt.Errorf("reading source application %s: %v", application, err)
+	}
+	p := &plan{source: source, targetName: m.Options.Mapping.Name(KindApplication, source.Name)}
+	mapping := m.Options.Mapping
+
+	if m.Options.wantsType(ApplicationConfig) {
+		p.config, err = m.Source.Application.GetApplicationConfig(source.ID)
+		if err != nil {
+			return nil, fmt.Errorf("exporting application config: %v", err)
+		}
+		remapConfig(p.config, mapping)
+		p.config.Name = p.targetName
+	}
+
+	if m.Options.wantsType(TransactionDetection) {
+		p.detection, err = m.Source.TxDetectionRule.ExportTransactionDetectionRules(strconv.Itoa(source.ID))
+		if err != nil {
+			return nil, fmt.Errorf("exporting transaction detection rules: %v", err)
+		}
+		r := &remapper{mapping: mapping, object: string(TransactionDetection)}
+		r.transactionDetection(p.detection)
+		p.references = append(p.references, r.references...)
+	}
+
+	if m.Options.wantsType(HealthRule) {
+		rules, err := m.Source.HealthRule.GetHealthRules(source.ID)
+		if err != nil {
+			return nil, fmt.Errorf("listing health rules: %v", err)
+		}
+		for _, rule := range rules {
+			detail, err := m.Source.HealthRule.GetRawHealthRule(source.ID, rule.ID)
+			if err != nil {
+				return nil, fmt.Errorf("reading health rule %s: %v", rule.Name, err)
+			}
+			if detail.Name() == "" {
+				detail["name"] = rule.Name
+			}
+			r := &remapper{mapping: mapping, object: fmt.Sprintf("%s %s", HealthRule, rule.Name)}
+			r.healthRule(detail)
+			p.references = append(p.references, r.references...)
+			p.healthRules = append(p.healthRules, detail)
+		}
+	}
+
+	if m.Options.wantsType(Action) {
+		actions, err := m.Source.Action.GetActions(source.ID)
+		if err != nil {
+			return nil, fmt.Errorf("listing actions: %v", err)
+		}
+		for _, action := range actions {
+			detail, err := m.Source.Action.GetActionDetails(source.ID, action.ID)
+			if err != nil {
+				return nil, fmt.Errorf("reading action %s: %v", action.Name, err)
+			}
+			p.actions = append(p.actions, detail)
+		}
+	}
+
+	if m.Options.wantsType(Policy) {
+		policies, err := m.Source.Policy.GetPolicies(source.ID)
+		if err != nil {
+			return nil, fmt.Errorf("listing policies: %v", err)
+		}
+		for _, policy := range policies {
+			detail, err := m.Source.Policy.GetPolicyDetails(source.ID, policy.ID)
+			if err != nil {
+				return nil, fmt.Errorf("reading policy %s: %v", policy.Name, err)
+			}
+			if detail.Name == nil {
+				detail.Name = &policy.Name
+			}
+			r := &remapper{mapping: mapping, object: fmt.Sprintf("%s %s", Policy, policy.Name)}
+			r.policy(detail)
+			p.references = append(p.references, r.references...)
+			p.policies = append(p.policies, detail)
+		}
+	}
+
+	if m.Options.wantsType(Dashboard) {
+		dashboards, err := m.Source.Dashboard.GetDashboards()
+		if err != nil {
+			return nil, fmt.Errorf("listing dashboards: %v", err)
+		}
+		for _, dashboard := range dashboards {
+			export, err := m.Source.Dashboard.GetDashboardExport(dashboard.ID)
+			if err != nil {
+				return nil, fmt.Errorf("exporting dashboard %s: %v", dashboard.Name, err)
+			}
+			if export.Name == nil {
+				export.Name = &dashboard.Name
+			}
+			// only dashboards showing the migrated application are copied
+			probe := &remapper{}
+			probe.dashboard(export)
+			if !refersTo(probe.references, KindApplication, source.Name) {
+				continue
+			}
+			r := &remapper{mapping: mapping, object: fmt.Sprintf("%s %s", Dashboard, dashboard.Name)}
+			r.dashboard(export)
+			p.references = append(p.references, r.references...)
+			p.dashboards = append(p.dashboards, export)
+		}
+	}
+
+	return p, nil
+}
+
+func refersTo(references []Reference, kind EntityKind, name string) bool {
+	for _, ref := range references {
+		if ref.Kind == kind && ref.SourceName == name {
+			return true
+		}
+	}
+	return false
+}
+
+// known collects the entity names available on the target once the migration is done.
+// Tiers and business transactions of the application config are created by the import,
+// backends and nodes only appear when agents report them.
+func (m *Migrator) known(target *appdrest.Application, p *plan) (map[EntityKind]map[string]bool, error) {
+	known := make(map[EntityKind]map[string]bool)
+	add := func(kind EntityKind, name string) {
+		if known[kind] == nil {
+			known[kind] = make(map[string]bool)
+		}
+		known[kind][name] = true
+	}
+
+	apps, err := m.Target.Application.GetApplications()
+	if err != nil {
+		return nil, err
+	}
+	for _, app := range apps {
+		add(KindApplication, app.Name)
+	}
+	add(KindApplication, p.targetName)
+
+	if p.config != nil {
+		for _, tier := range p.config.Tiers {
+			add(KindTier, tier.Name)
+		}
+		for _, bt := range p.config.BusinessTransactions {
+			add(KindBusinessTransaction, bt.Name)
+		}
+	}
+	for _, rule := range p.healthRules {
+		add(KindHealthRule, rule.Name())
+	}
+	for _, action := range p.actions {
+		add(KindAction, action.Name())
+	}
+
+	if target == nil {
+		return known, nil
+	}
+
+	tiers, err := m.Target.Tier.GetTiers(target.ID)
+	if err != nil {
+		return nil, err
+	}
+	for _, tier := range tiers {
+		add(KindTier, tier.Name)
+	}
+	bts, err := m.Target.BusinessTransaction.GetBusinessTransactions(target.ID)
+	if err != nil {
+		return nil, err
+	}
+	for _, bt := range bts {
+		add(KindBusinessTransaction, bt.Name)
+	}
+	backends, err := m.Target.Backend.GetBackends(strconv.Itoa(target.ID))
+	if err != nil {
+		return nil, err
+	}
+	for _, backend := range backends {
+		add(KindBackend, backend.Name)
+	}
+	nodes, err := m.Target.Node.GetNodes(strconv.Itoa(target.ID))
+	if err != nil {
+		return nil, err
+	}
+	for _, node := range nodes {
+		add(KindNode, node.Name)
+	}
+	rules, err := m.Target.HealthRule.GetHealthRules(target.ID)
+	if err != nil {
+		return nil, err
+	}
+	for _, rule := range rules {
+		add(KindHealthRule, rule.Name)
+	}
+	actions, err := m.Target.Action.GetActions(target.ID)
+	if err != nil {
+		return nil, err
+	}
+	for _, action := range actions {
+		add(KindAction, action.Name)
+	}
+	return known, nil
+}
+
+// unresolved returns the distinct references missing in known
+func unresolved(references []Reference, known map[EntityKind]map[string]bool) []Reference {
+	seen := make(map[Reference]bool)
+	var missing []Reference
+	for _, ref := range references {
+		if known[ref.Kind][ref.Name] || seen[ref] {
+			continue
+		}
+		seen[ref] = true
+		missing = append(missing, ref)
+	}
+	sort.Slice(missing, func(i, j int) bool {
+		if missing[i].Kind != missing[j].Kind {
+			return missing[i].Kind < missing[j].Kind
+		}
+		if missing[i].Name != missing[j].Name {
+			return missing[i].Name < missing[j].Name
+		}
+		return missing[i].Object < missing[j].Object
+	})
+	return missing
+}
+
+// apply writes the objects to the target in dependency order, target is nil in a dry run for a new application
+func (m *Migrator) apply(report *Report, target *appdrest.Application, p *plan) {
+	step := func(t ObjectType, name string, action string, err error) {
+		s := Step{Type: t, Name: name, Action: action}
+		if err != nil {
+			s.Action = Failed
+			s.Reason = err.Error()
+		} else if action == Skipped {
+			s.Reason = "already exists"
+		}
+		report.Steps = append(report.Steps, s)
+	}
+	dryRun := m.Options.DryRun
+
+	if p.config != nil {
+		var err error
+		if !dryRun {
+			err = m.Target.Application.UploadApplicationConfig(target.ID, p.config, m.Options.Overwrite)
+		}
+		step(ApplicationConfig, p.targetName, Imported, err)
+	}
+
+	if p.detection != nil {
+		var err error
+		if !dryRun {
+			err = m.Target.TxDetectionRule.ImportTransactionDetectionRules(strconv.Itoa(target.ID), p.detection)
+		}
+		step(TransactionDetection, p.targetName, Imported, err)
+	}
+
+	var existingRules map[string]int
+	var existingActions map[string]int
+	var existingPolicies map[string]int
+	if target != nil {
+		var err error
+		existingRules, existingActions, existingPolicies, err = m.existing(target.ID)
+		if err != nil {
+			step(ApplicationConfig, p.targetName, Failed, err)
+			return
+		}
+	}
+
+	for _, rule := range p.healthRules {
+		name := rule.Name()
+		action, id := m.upsert(existingRules, name)
+		var err error
+		if !dryRun {
+			switch action {
+			case Created:
+				delete(rule, "id")
+				err = m.Target.HealthRule.CreateRawHealthRule(target.ID, rule)
+			case Updated:
+				rule["id"] = id
+				err = m.Target.HealthRule.UpdateRawHealthRule(target.ID, id, rule)
+			}
+		}
+		step(HealthRule, name, action, err)
+	}
+
+	for _, detail := range p.actions {
+		name := detail.Name()
+		action, id := m.upsert(existingActions, name)
+		var err error
+		if !dryRun {
+			switch action {
+			case Created:
+				delete(detail, "id")
+				err = m.Target.Action.CreateAction(target.ID, detail)
+			case Updated:
+				detail["id"] = id
+				err = m.Target.Action.UpdateAction(target.ID, id, detail)
+			}
+		}
+		step(Action, name, action, err)
+	}
+
+	for _, policy := range p.policies {
+		name := *policy.Name
+		action, id := m.upsert(existingPolicies, name)
+		var err error
+		if !dryRun {
+			switch action {
+			case Created:
+				policy.ID = nil
+				err = m.Target.Policy.CreatePolicy(target.ID, policy)
+			case Updated:
+				policy.ID = &id
+				err = m.Target.Policy.UpdatePolicy(target.ID, id, policy)
+			}
+		}
+		step(Policy, name, action, err)
+	}
+
+	if len(p.dashboards) == 0 {
+		return
+	}
+	dashboards, err := m.Target.Dashboard.GetDashboards()
+	if err != nil {
+		step(Dashboard, "", Failed, err)
+		return
+	}
+	existingDashboards := make(map[string]int)
+	for _, dashboard := range dashboards {
+		existingDashboards[dashboard.Name] = dashboard.ID
+	}
+	for _, dashboard := range p.dashboards {
+		name := *dashboard.Name
+		action, id := m.upsert(existingDashboards, name)
+		var err error
+		if !dryRun && action != Skipped {
+			if action == Updated {
+				// dashboards can only be replaced as a whole
+				_, err = m.Target.Dashboard.ReplaceDashboard(id, dashboard)
+			} else {
+				_, err = m.Target.Dashboard.ImportDashboard(dashboard)
+			}
+		}
+		step(Dashboard, name, action, err)
+	}
+}
+
+// upsert decides how an object is written based on the IDs of existing objects by name
+func (m *Migrator) upsert(existing map[string]int, name string) (string, int) {
+	id, ok := existing[name]
+	if !ok {
+		return Created, 0
+	}
+	if !m.Options.Overwrite {
+		return Skipped, id
+	}
+	return Updated, id
+}
+
+// existing returns the IDs of the health rules, actions and policies of the target application by name
+func (m *Migrator) existing(appID int) (map[string]int, map[string]int, map[string]int, error) {
+	rules := make(map[string]int)
+	actions := make(map[string]int)
+	policies := make(map[string]int)
+
+	healthRules, err := m.Target.HealthRule.GetHealthRules(appID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	for _, rule := range healthRules {
+		rules[rule.Name] = rule.ID
+	}
+	targetActions, err := m.Target.Action.GetActions(appID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	for _, action := range targetActions {
+		actions[action.Name] = action.ID
+	}
+	targetPolicies, err := m.Target.Policy.GetPolicies(appID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	for _, policy := range targetPolicies {
+		policies[policy.Name] = policy.ID
+	}
+	return rules, actions, policies, nil
+}
+
+// mapIDs matches application, tiers, business transactions and backends of source and target by mapped name.
+// The IDs are informational, e.g. to rewrite links: migrated objects refer to entities by name only,
+// so nothing has to be rewritten with them. Names that cannot be resolved are reported in Report.Unresolved.
+func (m *Migrator) mapIDs(report *Report, target *appdrest.Application) error {
+	source, err := m.Source.Application.GetApplication(report.SourceApplication)
+	if err != nil {
+		return err
+	}
+	report.IDs[KindApplication] = map[int]int{source.ID: target.ID}
+	mapping := m.Options.Mapping
+
+	sourceTiers, err := m.Source.Tier.GetTiers(source.ID)
+	if err != nil {
+		return err
+	}
+	targetTiers, err := m.Target.Tier.GetTiers(target.ID)
+	if err != nil {
+		return err
+	}
+	report.IDs[KindTier] = matchIDs(len(sourceTiers), len(targetTiers),
+		func(i int) (string, int) { return mapping.Name(KindTier, sourceTiers[i].Name), sourceTiers[i].ID },
+		func(i int) (string, int) { return targetTiers[i].Name, targetTiers[i].ID })
+
+	sourceBTs, err := m.Source.BusinessTransaction.GetBusinessTransactions(source.ID)
+	if err != nil {
+		return err
+	}
+	targetBTs, err := m.Target.BusinessTransaction.GetBusinessTransactions(target.ID)
+	if err != nil {
+		return err
+	}
+	// business transaction names are only unique within a tier
+	report.IDs[KindBusinessTransaction] = matchIDs(len(sourceBTs), len(targetBTs),
+		func(i int) (string, int) {
+			return mapping.Name(KindTier, sourceBTs[i].TierName) + "|" + mapping.Name(KindBusinessTransaction, sourceBTs[i].Name), sourceBTs[i].ID
+		},
+		func(i int) (string, int) { return targetBTs[i].TierName + "|" + targetBTs[i].Name, targetBTs[i].ID })
+
+	sourceBackends, err := m.Source.Backend.GetBackends(strconv.Itoa(source.ID))
+	if err != nil {
+		return err
+	}
+	targetBackends, err := m.Target.Backend.GetBackends(strconv.Itoa(target.ID))
+	if err != nil {
+		return err
+	}
+	report.IDs[KindBackend] = matchIDs(len(sourceBackends), len(targetBackends),
+		func(i int) (string, int) {
+			return mapping.Name(KindBackend, sourceBackends[i].Name), sourceBackends[i].ID
+		},
+		func(i int) (string, int) { return targetBackends[i].Name, targetBackends[i].ID })
+	return nil
+}
+
+// matchIDs pairs source and target IDs of entities with the same key
+func matchIDs(sources int, targets int, source func(int) (string, int), target func(int) (string, int)) map[int]int {
+	byKey := make(map[string]int, targets)
+	for i := 0; i < targets; i++ {
+		key, id := target(i)
+		byKey[key] = id
+	}
+	ids := make(map[int]int)
+	for i := 0; i < sources; i++ {
+		key, id := source(i)
+		if targetID, ok := byKey[key]; ok {
+			ids[id] = targetID
+		}
+	}
+	return ids
+}
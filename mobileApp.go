@@ -0,0 +1,170 @@
+/*
+MIT License
+
+Copyright (c) 2026 Cisco Systems, Inc. and its affiliates
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+
+*/
+
+package appdrest
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// Consts for the mobile metric tree and common mobile metrics
+const (
+	MetricTreeMobile          = "Mobile"
+	MetricTreeMobileApps      = "Apps"
+	MetricTreeNetworkRequests = "Network Requests"
+
+	MetricNetworkRequestTime     = "Network Request Time (ms)"
+	MetricHTTPErrorsPerMinute    = "HTTP Error per Minute"
+	MetricNetworkErrorsPerMinute = "Network Errors per Minute"
+	MetricCrashesPerMinute       = "Crashes per Minute"
+	MetricSessionsPerMinute      = "Sessions per Minute"
+	MetricAppStartTime           = "App Start Time (ms)"
+)
+
+// MobileAppContainer is an EUM mobile application, it groups the mobile apps of all platforms
+// and its ID takes the place of the application ID in metric queries
+type MobileAppContainer struct {
+	ID                 int    `json:"id"`
+	Name               string `json:"name"`
+	Description        string `json:"description"`
+	Active             bool   `json:"active"`
+	AccountGUID        string `json:"accountGuid"`
+	NumberOfMobileApps int    `json:"numberOfMobileApps"`
+}
+
+// MobileApp is one mobile application of a mobile app container on one platform
+type MobileApp struct {
+	ContainerID int
+	Platform    string // e.g. "iOS" or "Android"
+	Name        string
+}
+
+// MetricPath returns the path of an application wide metric of the mobile app
+func (a *MobileApp) MetricPath(metric string) MetricPath {
+	return MobileAppMetricPath(a.Platform, a.Name, metric)
+}
+
+// MobileNetworkRequest is a network request reported by a mobile app
+type MobileNetworkRequest struct {
+	App  *MobileApp
+	Name string
+}
+
+// MetricPath returns the path of a metric of the network request
+func (r *MobileNetworkRequest) MetricPath(metric string) MetricPath {
+	return MobileNetworkRequestMetricPath(r.App.Platform, r.App.Name, r.Name, metric)
+}
+
+// MobileAppMetricPath builds "Mobile|platform|Apps|app|metric"
+func MobileAppMetricPath(platform string, app string, metric string) MetricPath {
+	return NewMetricPath(MetricTreeMobile, platform, MetricTreeMobileApps, app).Append(metric)
+}
+
+// MobileNetworkRequestMetricPath builds "Mobile|platform|Apps|app|Network Requests|request|metric", request may be "*"
+func MobileNetworkRequestMetricPath(platform string, app string, request string, metric string) MetricPath {
+	return NewMetricPath(MetricTreeMobile, platform, MetricTreeMobileApps, app, MetricTreeNetworkRequests, request).Append(metric)
+}
+
+// MobileAppService intermediates requests for EUM mobile applications
+type MobileAppService service
+
+// GetMobileAppContainers returns the mobile app containers of the controller
+func (s *MobileAppService) GetMobileAppContainers() ([]*MobileAppContainer, error) {
+	apps, err := s.client.Application.GetAllInternalApplications()
+	if err != nil {
+		return nil, err
+	}
+
+	containers := make([]*MobileAppContainer, 0, len(apps.MobileAppContainers))
+	for _, app := range apps.MobileAppContainers {
+		containers = append(containers, &MobileAppContainer{
+			ID:                 app.ID,
+			Name:               app.Name,
+			Description:        app.Description,
+			Active:             app.Active,
+			AccountGUID:        app.AccountGUID,
+			NumberOfMobileApps: app.ApplicationTypeInfo.NumberOfMobileApps,
+		})
+	}
+	return containers, nil
+}
+
+// GetMobileApps returns the mobile apps of a container for all platforms
+func (s *MobileAppService) GetMobileApps(containerID int) ([]*MobileApp, error) {
+	appID := strconv.Itoa(containerID)
+	platforms, err := s.client.MetricData.GetMetricHierarchy(appID, MetricTreeMobile)
+	if err != nil {
+		return nil, fmt.Errorf("mobile platforms: %v", err)
+	}
+
+	var apps []*MobileApp
+	for _, platform := range platforms {
+		if platform.Type != "folder" {
+			continue
+		}
+		folder := NewMetricPath(MetricTreeMobile, platform.Name, MetricTreeMobileApps).String()
+		names, err := s.client.MetricData.GetMetricHierarchy(appID, folder)
+		if err != nil {
+			return nil, fmt.Errorf("mobile apps for %s: %v", platform.Name, err)
+		}
+		for _, name := range names {
+			if name.Type == "folder" {
+				apps = append(apps, &MobileApp{ContainerID: containerID, Platform: platform.Name, Name: name.Name})
+			}
+		}
+	}
+	return apps, nil
+}
+
+// GetNetworkRequests returns the network requests reported by a mobile app
+func (s *MobileAppService) GetNetworkRequests(app *MobileApp) ([]*MobileNetworkRequest, error) {
+	folder := NewMetricPath(MetricTreeMobile, app.Platform, MetricTreeMobileApps, app.Name, MetricTreeNetworkRequests).String()
+	metrics, err := s.client.MetricData.GetMetricHierarchy(strconv.Itoa(app.ContainerID), folder)
+	if err != nil {
+		return nil, fmt.Errorf("mobile network requests: %v", err)
+	}
+
+	var requests []*MobileNetworkRequest
+	for _, metric := range metrics {
+		if metric.Type == "folder" {
+			requests = append(requests, &MobileNetworkRequest{App: app, Name: metric.Name})
+		}
+	}
+	return requests, nil
+}
+
+// GetNetworkRequestMetricData obtains one metric for all network requests of a mobile app with a single wildcard query
+func (s *MobileAppService) GetNetworkRequestMetricData(app *MobileApp, metric string, rollup bool, timeRangeType string, durationInMins int, startTime time.Time, endTime time.Time) ([]*MetricData, error) {
+	path := MobileNetworkRequestMetricPath(app.Platform, app.Name, MetricPathWildcard, metric)
+	return s.client.MetricData.GetMetricData(strconv.Itoa(app.ContainerID), path.String(), rollup, timeRangeType, durationInMins, startTime, endTime)
+}
+
+// GetAppMetricData obtains an application wide metric of a mobile app
+func (s *MobileAppService) GetAppMetricData(app *MobileApp, metric string, rollup bool, timeRangeType string, durationInMins int, startTime time.Time, endTime time.Time) ([]*MetricData, error) {
+	path := MobileAppMetricPath(app.Platform, app.Name, metric)
+	return s.client.MetricData.GetMetricData(strconv.Itoa(app.ContainerID), path.String(), rollup, timeRangeType, durationInMins, startTime, endTime)
+}
@@ -26,14 +26,25 @@ SOFTWARE.
 package appdrest
 
 import (
+	"bytes"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"net"
+	"strconv"
+	"strings"
 )
 
+// MaxHistoricalNodesPerCall is the largest number of nodes marked historical in one request
+// Added 2026 Cisco Systems, Inc.
+const MaxHistoricalNodesPerCall = 100
+
 // Node represents one node within one Application
+// Modified by 2026 Cisco Systems, Inc.
 type Node struct {
 	AppAgentVersion     string      `json:"appAgentVersion"`
 	MachineAgentVersion string      `json:"machineAgentVersion"`
-	AgentType           string      `json:"agentType"`
+	AgentType           AgentType   `json:"agentType"`
 	Type                string      `json:"type"`
 	MachineName         string      `json:"machineName"`
 	AppAgentPresent     bool        `json:"appAgentPresent"`
@@ -44,10 +55,101 @@ type Node struct {
 	TierName            string      `json:"tierName"`
 	MachineAgentPresent bool        `json:"machineAgentPresent"`
 	Name                string      `json:"name"`
-	IPAddresses         interface{} `json:"ipAddresses"`
+	IPAddresses         IPAddresses `json:"ipAddresses"`
 	ID                  int         `json:"id"`
 }
 
+// IPAddresses are the IPv4 and IPv6 addresses of a node.
+// The controller wraps them as {"ipAddresses": [...]}, unparsable entries are dropped.
+// Added 2026 Cisco Systems, Inc.
+type IPAddresses []net.IP
+
+type ipAddressesJSON struct {
+	IPAddresses []string `json:"ipAddresses"`
+}
+
+// UnmarshalJSON accepts the controller format, a plain list and null
+func (a *IPAddresses) UnmarshalJSON(b []byte) error {
+	*a = nil
+	b = bytes.TrimSpace(b)
+	if len(b) == 0 || bytes.Equal(b, []byte("null")) {
+		return nil
+	}
+
+	var raw []string
+	if b[0] == '[' {
+		if err := json.Unmarshal(b, &raw); err != nil {
+			return err
+		}
+	} else {
+		var wrapped ipAddressesJSON
+		if err := json.Unmarshal(b, &wrapped); err != nil {
+			return err
+		}
+		raw = wrapped.IPAddresses
+	}
+
+	for _, s := range raw {
+		// zone suffixes such as "fe80::1%eth0" are not part of net.IP
+		if i := strings.IndexByte(s, '%'); i >= 0 {
+			s = s[:i]
+		}
+		if ip := net.ParseIP(strings.TrimSpace(s)); ip != nil {
+			*a = append(*a, ip)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON writes the controller format
+func (a IPAddresses) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	return json.Marshal(ipAddressesJSON{IPAddresses: a.Strings()})
+}
+
+// Strings returns the addresses in text form
+func (a IPAddresses) Strings() []string {
+	s := make([]string, len(a))
+	for i, ip := range a {
+		s[i] = ip.String()
+	}
+	return s
+}
+
+// IPv4 returns the IPv4 addresses
+func (a IPAddresses) IPv4() []net.IP {
+	var v4 []net.IP
+	for _, ip := range a {
+		if ip.To4() != nil {
+			v4 = append(v4, ip)
+		}
+	}
+	return v4
+}
+
+// IPv6 returns the IPv6 addresses
+func (a IPAddresses) IPv6() []net.IP {
+	var v6 []net.IP
+	for _, ip := range a {
+		if ip.To4() == nil {
+			v6 = append(v6, ip)
+		}
+	}
+	return v6
+}
+
+// Contains reports whether ip is one of the addresses
+func (a IPAddresses) Contains(ip net.IP) bool {
+	for _, candidate := range a {
+		if candidate.Equal(ip) {
+			return true
+		}
+	}
+	return false
+}
+
 // NodeService intermediates Node requests
 type NodeService service
 
@@ -78,3 +180,29 @@ func (s *NodeService) GetNode(appIDOrName string, nodeNameOrID string) (*Node, e
 
 	return nodes[0], nil
 }
+
+// MarkNodesHistorical marks nodes as historical, at most MaxHistoricalNodesPerCall at once.
+// Historical nodes stop counting against licenses and are removed once their data expires.
+// Added 2026 Cisco Systems, Inc.
+func (s *NodeService) MarkNodesHistorical(nodeIDs []int) error {
+
+	if len(nodeIDs) == 0 {
+		return errors.New("no nodes to mark historical")
+	}
+	if len(nodeIDs) > MaxHistoricalNodesPerCall {
+		return fmt.Errorf("%d nodes exceed the limit of %d per call", len(nodeIDs), MaxHistoricalNodesPerCall)
+	}
+
+	ids := make([]string, len(nodeIDs))
+	for i, id := range nodeIDs {
+		ids[i] = strconv.Itoa(id)
+	}
+	url := fmt.Sprintf("controller/rest/mark-nodes-historical?application-component-node-ids=%s", strings.Join(ids, ","))
+
+	err := s.client.Rest("POST", url, nil, nil)
+	if err != nil {
+		return fmt.Errorf("marking nodes %s historical: %w", strings.Join(ids, ","), err)
+	}
+
+	return nil
+}
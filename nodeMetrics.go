@@ -0,0 +1,283 @@
+/*
+MIT License
+
+Copyright (c) 2026 Cisco Systems, Inc. and its affiliates
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+
+*/
+
+package appdrest
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Consts for common JVM metrics, relative to the JVM tree of a node
+const (
+	MetricJVMHeapUsed         = "Memory|Heap|Current Usage (MB)"
+	MetricJVMHeapMax          = "Memory|Heap|Max Available (MB)"
+	MetricJVMHeapUsedPercent  = "Memory|Heap|Used %"
+	MetricJVMGCTimePerMinute  = "Garbage Collection|GC Time Spent Per Min (ms)"
+	MetricJVMMajorGCPerMinute = "Garbage Collection|Number of Major Collections Per Min"
+	MetricJVMMinorGCPerMinute = "Garbage Collection|Number of Minor Collections Per Min"
+)
+
+// Consts for common CLR metrics, relative to the CLR tree of a node
+const (
+	MetricCLRHeapUsed        = "Memory|Heap|Current Usage (KB)"
+	MetricCLRHeapCommitted   = "Memory|Heap|Committed (KB)"
+	MetricCLRGCTimePercent   = "Garbage Collection|GC Time Spent (%)"
+	MetricCLRGen2Collections = "Garbage Collection|Number of Gen2 Collections"
+)
+
+// NodeAvailability is the share of time a node's agent reported during a time range
+type NodeAvailability struct {
+	Node      *Node
+	Metric    string // availability metric used, app agent or machine agent
+	Start     time.Time
+	End       time.Time
+	Buckets   int           // buckets expected in the time range
+	Reported  int           // buckets with a report
+	Percent   float64       // Reported / Buckets * 100
+	Frequency time.Duration // bucket size returned by the controller
+	Values    []MetricValue
+}
+
+// GetNodeAvailability computes the availability of a node from its agent availability metric.
+// Nodes without an app agent use the machine agent availability.
+// Added 2026 Cisco Systems, Inc.
+func (s *NodeService) GetNodeAvailability(appIDOrName string, node *Node, timeRangeType string, durationInMins int, startTime time.Time, endTime time.Time) (*NodeAvailability, error) {
+
+	metric := MetricAgentAvailability
+	path := AgentAvailabilityMetricPath(node.TierName, node.Name)
+	if !node.AppAgentPresent && node.MachineAgentPresent {
+		metric = MetricMachineAvailability
+		path = MachineAvailabilityMetricPath(node.TierName, node.Name)
+	}
+
+	data, err := s.client.MetricData.GetMetricData(appIDOrName, path.String(), false, timeRangeType, durationInMins, startTime, endTime)
+	if err != nil {
+		return nil, err
+	}
+
+	start, end := metricTimeRange(timeRangeType, durationInMins, startTime, endTime, time.Now())
+	availability := &NodeAvailability{Node: node, Metric: metric, Start: start, End: end, Frequency: time.Minute}
+	for _, metricData := range data {
+		if frequency := metricFrequency(metricData.Frequency); frequency > 0 {
+			availability.Frequency = frequency
+		}
+		availability.Values = append(availability.Values, metricData.MetricValues...)
+	}
+
+	availability.Buckets = int(end.Sub(start) / availability.Frequency)
+	for _, value := range availability.Values {
+		if value.Value > 0 || value.Sum > 0 {
+			availability.Reported++
+		}
+	}
+	if availability.Reported > availability.Buckets {
+		availability.Buckets = availability.Reported
+	}
+	if availability.Buckets > 0 {
+		availability.Percent = float64(availability.Reported) / float64(availability.Buckets) * 100
+	}
+	return availability, nil
+}
+
+// metricFrequency converts MetricData.Frequency such as "ONE_MIN" to a duration
+func metricFrequency(frequency string) time.Duration {
+	switch frequency {
+	case "ONE_MIN":
+		return time.Minute
+	case "TEN_MIN":
+		return 10 * time.Minute
+	case "SIXTY_MIN":
+		return time.Hour
+	}
+	return 0
+}
+
+// JVMMemory summarizes heap and garbage collection of a JVM over a time range
+type JVMMemory struct {
+	HeapUsedMB            int
+	HeapMaxMB             int
+	HeapUsedPercent       int
+	GCTimePerMinuteMS     int
+	MajorGCsPerMinute     int
+	MinorGCsPerMinute     int
+	PeakHeapUsedMB        int
+	PeakGCTimePerMinuteMS int
+}
+
+// GetJVMMemory obtains heap and GC metrics of a Java node, values are averages over the time range
+// Added 2026 Cisco Systems, Inc.
+func (s *NodeService) GetJVMMemory(appIDOrName string, tier string, node string, timeRangeType string, durationInMins int, startTime time.Time, endTime time.Time) (*JVMMemory, error) {
+
+	paths := []MetricPath{JVMMetricPath(tier, node, "*|*"), JVMMetricPath(tier, node, "*|*|*")}
+	values, err := s.rolledUp(appIDOrName, paths, timeRangeType, durationInMins, startTime, endTime)
+	if err != nil {
+		return nil, err
+	}
+	if len(values) == 0 {
+		return nil, fmt.Errorf("no JVM metrics for node %s", node)
+	}
+
+	memory := &JVMMemory{}
+	for metric, value := range values {
+		switch metric {
+		case MetricJVMHeapUsed:
+			memory.HeapUsedMB, memory.PeakHeapUsedMB = value.Value, value.Max
+		case MetricJVMHeapMax:
+			memory.HeapMaxMB = value.Value
+		case MetricJVMHeapUsedPercent:
+			memory.HeapUsedPercent = value.Value
+		case MetricJVMGCTimePerMinute:
+			memory.GCTimePerMinuteMS, memory.PeakGCTimePerMinuteMS = value.Value, value.Max
+		case MetricJVMMajorGCPerMinute:
+			memory.MajorGCsPerMinute = value.Value
+		case MetricJVMMinorGCPerMinute:
+			memory.MinorGCsPerMinute = value.Value
+		}
+	}
+	return memory, nil
+}
+
+// CLRMemory summarizes heap and garbage collection of a .NET CLR over a time range
+type CLRMemory struct {
+	HeapUsedKB        int
+	HeapCommittedKB   int
+	GCTimePercent     int
+	Gen2Collections   int
+	PeakHeapUsedKB    int
+	PeakGCTimePercent int
+}
+
+// GetCLRMemory obtains heap and GC metrics of a .NET node, values are averages over the time range
+// Added 2026 Cisco Systems, Inc.
+func (s *NodeService) GetCLRMemory(appIDOrName string, tier string, node string, timeRangeType string, durationInMins int, startTime time.Time, endTime time.Time) (*CLRMemory, error) {
+
+	paths := []MetricPath{CLRMetricPath(tier, node, "*|*"), CLRMetricPath(tier, node, "*|*|*")}
+	values, err := s.rolledUp(appIDOrName, paths, timeRangeType, durationInMins, startTime, endTime)
+	if err != nil {
+		return nil, err
+	}
+	if len(values) == 0 {
+		return nil, fmt.Errorf("no CLR metrics for node %s", node)
+	}
+
+	memory := &CLRMemory{}
+	for metric, value := range values {
+		switch metric {
+		case MetricCLRHeapUsed:
+			memory.HeapUsedKB, memory.PeakHeapUsedKB = value.Value, value.Max
+		case MetricCLRHeapCommitted:
+			memory.HeapCommittedKB = value.Value
+		case MetricCLRGCTimePercent:
+			memory.GCTimePercent, memory.PeakGCTimePercent = value.Value, value.Max
+		case MetricCLRGen2Collections:
+			memory.Gen2Collections = value.Value
+		}
+	}
+	return memory, nil
+}
+
+// rolledUp fetches rolled up metrics and returns the value per metric relative to the JVM or CLR tree.
+// Wildcards only match paths of the same depth, so one path per depth is queried.
+func (s *NodeService) rolledUp(appIDOrName string, paths []MetricPath, timeRangeType string, durationInMins int, startTime time.Time, endTime time.Time) (map[string]MetricValue, error) {
+	values := make(map[string]MetricValue)
+	for _, path := range paths {
+		data, err := s.client.MetricData.GetMetricData(appIDOrName, path.String(), true, timeRangeType, durationInMins, startTime, endTime)
+		if err != nil {
+			return nil, err
+		}
+		for _, metricData := range data {
+			if len(metricData.MetricValues) == 0 {
+				continue
+			}
+			values[metricData.Path().Entities().Metric] = metricData.MetricValues[0]
+		}
+	}
+	return values, nil
+}
+
+// NodeLocation is a node together with the application it belongs to
+type NodeLocation struct {
+	Application *Application
+	Node        *Node
+}
+
+// FindNodesByIP returns the nodes having ip among their addresses.
+// All APM applications are searched when no application names or IDs are given.
+// Added 2026 Cisco Systems, Inc.
+func (s *NodeService) FindNodesByIP(ip string, applications ...string) ([]NodeLocation, error) {
+	addr := net.ParseIP(ip)
+	if addr == nil {
+		return nil, fmt.Errorf("invalid IP address %q", ip)
+	}
+	return s.findNodes(applications, func(node *Node) bool { return node.IPAddresses.Contains(addr) })
+}
+
+// FindNodesByMachineName returns the nodes running on a machine, the name is compared case insensitively.
+// All APM applications are searched when no application names or IDs are given.
+// Added 2026 Cisco Systems, Inc.
+func (s *NodeService) FindNodesByMachineName(machineName string, applications ...string) ([]NodeLocation, error) {
+	if machineName == "" {
+		return nil, errors.New("empty machine name")
+	}
+	return s.findNodes(applications, func(node *Node) bool { return strings.EqualFold(node.MachineName, machineName) })
+}
+
+func (s *NodeService) findNodes(applications []string, match func(*Node) bool) ([]NodeLocation, error) {
+	var apps []*Application
+	if len(applications) == 0 {
+		all, err := s.client.Application.GetApplications()
+		if err != nil {
+			return nil, err
+		}
+		apps = all
+	} else {
+		for _, appNameOrID := range applications {
+			app, err := s.client.Application.GetApplication(appNameOrID)
+			if err != nil {
+				return nil, err
+			}
+			apps = append(apps, app)
+		}
+	}
+
+	var found []NodeLocation
+	for _, app := range apps {
+		nodes, err := s.GetNodes(strconv.Itoa(app.ID))
+		if err != nil {
+			return nil, fmt.Errorf("listing nodes of %s: %v", app.Name, err)
+		}
+		for _, node := range nodes {
+			if match(node) {
+				found = append(found, NodeLocation{Application: app, Node: node})
+			}
+		}
+	}
+	return found, nil
+}
@@ -0,0 +1,357 @@
+/*
+MIT License
+
+Copyright (c) 2026 Cisco Systems, Inc. and its affiliates
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+
+*/
+
+package appdrest
+
+// Minimal Parquet writer used by the metric export.
+// It only supports flat schemas of required INT64 and BYTE_ARRAY columns,
+// PLAIN encoding, no compression and one data page per column chunk.
+// File metadata is serialized with the Thrift compact protocol.
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+)
+
+// DefaultParquetRowGroupSize is the number of rows buffered before a row group is written
+const DefaultParquetRowGroupSize = 65536
+
+const parquetMagic = "PAR1"
+
+// parquet physical types
+const (
+	parquetInt64     int32 = 2
+	parquetByteArray int32 = 6
+)
+
+// parquet converted types, parquetNoConversion is not written
+const (
+	parquetNoConversion    int32 = -1
+	parquetUTF8            int32 = 0
+	parquetTimestampMillis int32 = 9
+)
+
+// parquet encodings
+const (
+	parquetEncodingPlain int32 = 0
+	parquetEncodingRLE   int32 = 3
+)
+
+// thrift compact protocol types
+const (
+	thriftI32    byte = 5
+	thriftI64    byte = 6
+	thriftBinary byte = 8
+	thriftList   byte = 9
+	thriftStruct byte = 12
+)
+
+type parquetColumn struct {
+	name      string
+	kind      int32
+	converted int32
+}
+
+type parquetChunk struct {
+	offset int64
+	size   int64
+}
+
+type parquetRowGroup struct {
+	numRows int64
+	chunks  []parquetChunk
+}
+
+type parquetWriter struct {
+	w          io.Writer
+	offset     int64
+	columns    []parquetColumn
+	buffers    []bytes.Buffer
+	rows       int64
+	groupSize  int64
+	totalRows  int64
+	rowGroups  []parquetRowGroup
+	started    bool
+	closed     bool
+	err        error
+	scratch    [8]byte
+	byteLength [4]byte
+}
+
+func newParquetWriter(w io.Writer, columns []parquetColumn, groupSize int) *parquetWriter {
+	if groupSize <= 0 {
+		groupSize = DefaultParquetRowGroupSize
+	}
+	return &parquetWriter{
+		w:         w,
+		columns:   columns,
+		buffers:   make([]bytes.Buffer, len(columns)),
+		groupSize: int64(groupSize),
+	}
+}
+
+func (p *parquetWriter) write(b []byte) error {
+	if p.err != nil {
+		return p.err
+	}
+	n, err := p.w.Write(b)
+	p.offset += int64(n)
+	p.err = err
+	return err
+}
+
+// writeRow appends one row, values must be int64 or string matching the column kinds
+func (p *parquetWriter) writeRow(values []interface{}) error {
+	if p.closed {
+		return fmt.Errorf("parquet: write after close")
+	}
+	if len(values) != len(p.columns) {
+		return fmt.Errorf("parquet: got %d values for %d columns", len(values), len(p.columns))
+	}
+	for i, value := range values {
+		buf := &p.buffers[i]
+		switch v := value.(type) {
+		case int64:
+			if p.columns[i].kind != parquetInt64 {
+				return fmt.Errorf("parquet: column %s does not accept int64", p.columns[i].name)
+			}
+			binary.LittleEndian.PutUint64(p.scratch[:], uint64(v))
+			buf.Write(p.scratch[:])
+		case string:
+			if p.columns[i].kind != parquetByteArray {
+				return fmt.Errorf("parquet: column %s does not accept string", p.columns[i].name)
+			}
+			binary.LittleEndian.PutUint32(p.byteLength[:], uint32(len(v)))
+			buf.Write(p.byteLength[:])
+			buf.WriteString(v)
+		default:
+			return fmt.Errorf("parquet: unsupported value type %T", value)
+		}
+	}
+	p.rows++
+	if p.rows >= p.groupSize {
+		return p.flush()
+	}
+	return nil
+}
+
+// flush writes the buffered rows as a row group
+func (p *parquetWriter) flush() error {
+	if !p.started {
+		if err := p.write([]byte(parquetMagic)); err != nil {
+			return err
+		}
+		p.started = true
+	}
+	if p.rows == 0 {
+		return nil
+	}
+
+	group := parquetRowGroup{numRows: p.rows}
+	for i := range p.columns {
+		data := p.buffers[i].Bytes()
+
+		t := &thriftWriter{}
+		t.i32(1, 0) // DATA_PAGE
+		t.i32(2, int32(len(data)))
+		t.i32(3, int32(len(data)))
+		t.beginStruct(5)
+		t.i32(1, int32(p.rows))
+		t.i32(2, parquetEncodingPlain)
+		t.i32(3, parquetEncodingRLE)
+		t.i32(4, parquetEncodingRLE)
+		t.endStruct()
+		t.stop()
+
+		chunk := parquetChunk{offset: p.offset, size: int64(t.buf.Len() + len(data))}
+		if err := p.write(t.buf.Bytes()); err != nil {
+			return err
+		}
+		if err := p.write(data); err != nil {
+			return err
+		}
+		group.chunks = append(group.chunks, chunk)
+		p.buffers[i].Reset()
+	}
+
+	p.rowGroups = append(p.rowGroups, group)
+	p.totalRows += p.rows
+	p.rows = 0
+	return nil
+}
+
+// close writes the remaining rows and the file footer
+func (p *parquetWriter) close() error {
+	if p.closed {
+		return p.err
+	}
+	if err := p.flush(); err != nil {
+		return err
+	}
+	p.closed = true
+
+	t := &thriftWriter{}
+	t.i32(1, 1)
+
+	t.listHeader(2, thriftStruct, len(p.columns)+1)
+	t.beginElement()
+	t.binary(4, []byte("schema"))
+	t.i32(5, int32(len(p.columns)))
+	t.endStruct()
+	for _, column := range p.columns {
+		t.beginElement()
+		t.i32(1, column.kind)
+		t.i32(3, 0) // REQUIRED
+		t.binary(4, []byte(column.name))
+		if column.converted != parquetNoConversion {
+			t.i32(6, column.converted)
+		}
+		t.endStruct()
+	}
+
+	t.i64(3, p.totalRows)
+
+	t.listHeader(4, thriftStruct, len(p.rowGroups))
+	for _, group := range p.rowGroups {
+		var groupSize int64
+		t.beginElement()
+		t.listHeader(1, thriftStruct, len(group.chunks))
+		for i, chunk := range group.chunks {
+			groupSize += chunk.size
+			t.beginElement()
+			t.i64(2, chunk.offset)
+			t.beginStruct(3)
+			t.i32(1, p.columns[i].kind)
+			t.listHeader(2, thriftI32, 2)
+			t.varint(zigzag(int64(parquetEncodingPlain)))
+			t.varint(zigzag(int64(parquetEncodingRLE)))
+			t.listHeader(3, thriftBinary, 1)
+			t.varint(uint64(len(p.columns[i].name)))
+			t.buf.WriteString(p.columns[i].name)
+			t.i32(4, 0) // UNCOMPRESSED
+			t.i64(5, group.numRows)
+			t.i64(6, chunk.size)
+			t.i64(7, chunk.size)
+			t.i64(9, chunk.offset)
+			t.endStruct()
+			t.endStruct()
+		}
+		t.i64(2, groupSize)
+		t.i64(3, group.numRows)
+		t.endStruct()
+	}
+
+	t.binary(6, []byte("appd-client-go"))
+	t.stop()
+
+	if err := p.write(t.buf.Bytes()); err != nil {
+		return err
+	}
+	binary.LittleEndian.PutUint32(p.byteLength[:], uint32(t.buf.Len()))
+	if err := p.write(p.byteLength[:]); err != nil {
+		return err
+	}
+	return p.write([]byte(parquetMagic))
+}
+
+// thriftWriter serializes structs with the Thrift compact protocol
+type thriftWriter struct {
+	buf    bytes.Buffer
+	lastID int16
+	stack  []int16
+}
+
+func zigzag(v int64) uint64 {
+	return uint64((v << 1) ^ (v >> 63))
+}
+
+func (t *thriftWriter) varint(v uint64) {
+	for v >= 0x80 {
+		t.buf.WriteByte(byte(v) | 0x80)
+		v >>= 7
+	}
+	t.buf.WriteByte(byte(v))
+}
+
+func (t *thriftWriter) fieldHeader(id int16, kind byte) {
+	delta := id - t.lastID
+	if delta > 0 && delta <= 15 {
+		t.buf.WriteByte(byte(delta)<<4 | kind)
+	} else {
+		t.buf.WriteByte(kind)
+		t.varint(zigzag(int64(id)))
+	}
+	t.lastID = id
+}
+
+func (t *thriftWriter) i32(id int16, v int32) {
+	t.fieldHeader(id, thriftI32)
+	t.varint(zigzag(int64(v)))
+}
+
+func (t *thriftWriter) i64(id int16, v int64) {
+	t.fieldHeader(id, thriftI64)
+	t.varint(zigzag(v))
+}
+
+func (t *thriftWriter) binary(id int16, v []byte) {
+	t.fieldHeader(id, thriftBinary)
+	t.varint(uint64(len(v)))
+	t.buf.Write(v)
+}
+
+func (t *thriftWriter) listHeader(id int16, kind byte, size int) {
+	t.fieldHeader(id, thriftList)
+	if size < 15 {
+		t.buf.WriteByte(byte(size)<<4 | kind)
+	} else {
+		t.buf.WriteByte(0xf0 | kind)
+		t.varint(uint64(size))
+	}
+}
+
+// beginStruct starts a struct valued field
+func (t *thriftWriter) beginStruct(id int16) {
+	t.fieldHeader(id, thriftStruct)
+	t.beginElement()
+}
+
+// beginElement starts a struct inside a list
+func (t *thriftWriter) beginElement() {
+	t.stack = append(t.stack, t.lastID)
+	t.lastID = 0
+}
+
+func (t *thriftWriter) endStruct() {
+	t.stop()
+	t.lastID = t.stack[len(t.stack)-1]
+	t.stack = t.stack[:len(t.stack)-1]
+}
+
+func (t *thriftWriter) stop() {
+	t.buf.WriteByte(0)
+}
@@ -0,0 +1,253 @@
+/*
+MIT License
+
+Copyright (c) 2026 Cisco Systems, Inc. and its affiliates
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+
+*/
+
+package appdrest
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// Policy describes basic info about a policy as returned by the query for all policies
+type Policy struct {
+	ID      int    `json:"id"`
+	Name    string `json:"name"`
+	Enabled bool   `json:"enabled"`
+}
+
+// PolicyAction is an action triggered by a policy
+type PolicyAction struct {
+	ActionName       *string `json:"actionName"`
+	ActionType       *string `json:"actionType"`
+	ActionTemplateID *int    `json:"actionTemplateId,omitempty"`
+}
+
+// PolicyHealthRuleScope selects the health rules whose events trigger a policy
+type PolicyHealthRuleScope struct {
+	HealthRuleScopeType *string  `json:"healthRuleScopeType"`
+	HealthRules         []string `json:"healthRules"`
+}
+
+// PolicyHealthRuleEvents selects the health rule events that trigger a policy
+type PolicyHealthRuleEvents struct {
+	HealthRuleEventTypes []string               `json:"healthRuleEventTypes"`
+	HealthRuleScope      *PolicyHealthRuleScope `json:"healthRuleScope"`
+}
+
+// PolicyEvents are the events that trigger a policy
+type PolicyEvents struct {
+	HealthRuleEvents *PolicyHealthRuleEvents `json:"healthRuleEvents"`
+	OtherEvents      []string                `json:"otherEvents"`
+	AnomalyEvents    []string                `json:"anomalyEvents"`
+	CustomEvents     []interface{}           `json:"customEvents"`
+}
+
+// PolicyDetail describes detail information about a specific policy
+// SelectedEntities depends on the entity type and is kept as generic JSON
+type PolicyDetail struct {
+	ID                    *int                   `json:"id,omitempty"`
+	Name                  *string                `json:"name"`
+	Enabled               *bool                  `json:"enabled"`
+	ExecuteActionsInBatch *bool                  `json:"executeActionsInBatch"`
+	FrequencyInMinutes    *int                   `json:"frequencyInMinutes"`
+	Actions               []*PolicyAction        `json:"actions"`
+	Events                *PolicyEvents          `json:"events"`
+	SelectedEntities      map[string]interface{} `json:"selectedEntities"`
+}
+
+// Action describes basic info about an action as returned by the query for all actions
+type Action struct {
+	ID         int    `json:"id"`
+	Name       string `json:"name"`
+	ActionType string `json:"actionType"`
+}
+
+// ActionDetail is the full definition of an action,
+// its fields depend on the action type so it is kept as generic JSON
+type ActionDetail map[string]interface{}
+
+// Name returns the name of the action
+func (a ActionDetail) Name() string {
+	name, _ := a["name"].(string)
+	return name
+}
+
+// PolicyService intermediates Policy requests
+type PolicyService service
+
+// GetPolicies obtains all policies of an application
+func (s *PolicyService) GetPolicies(appID int) ([]*Policy, error) {
+
+	url := "controller/alerting/rest/v1/applications/" + strconv.Itoa(appID) + "/policies"
+
+	var policies []*Policy
+	err := s.client.Rest("GET", url, &policies, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return policies, nil
+}
+
+// GetPolicyDetails obtains a single policy of an application
+func (s *PolicyService) GetPolicyDetails(appID int, policyID int) (*PolicyDetail, error) {
+
+	url := "controller/alerting/rest/v1/applications/" + strconv.Itoa(appID) + "/policies/" + strconv.Itoa(policyID)
+
+	var policy *PolicyDetail
+	err := s.client.Rest("GET", url, &policy, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return policy, nil
+}
+
+// CreatePolicy - create policy for an application
+func (s *PolicyService) CreatePolicy(appID int, policy *PolicyDetail) error {
+
+	url := "controller/alerting/rest/v1/applications/" + strconv.Itoa(appID) + "/policies"
+
+	err := s.client.RestInternal("POST", url, nil, policy)
+	if err != nil {
+		if fmt.Sprintf("%s", err) == "EOF" { // successful call returns EOF error -> empty body
+			return nil
+		}
+		return err
+	}
+
+	return nil
+}
+
+// UpdatePolicy - update a policy of an application
+func (s *PolicyService) UpdatePolicy(appID int, policyID int, policy *PolicyDetail) error {
+
+	url := "controller/alerting/rest/v1/applications/" + strconv.Itoa(appID) + "/policies/" + strconv.Itoa(policyID)
+
+	err := s.client.RestInternal("PUT", url, nil, policy)
+	if err != nil {
+		if fmt.Sprintf("%s", err) == "EOF" { // successful call returns EOF error -> empty body
+			return nil
+		}
+		return err
+	}
+
+	return nil
+}
+
+// DeletePolicy - delete a policy of an application
+func (s *PolicyService) DeletePolicy(appID int, policyID int) error {
+
+	url := "controller/alerting/rest/v1/applications/" + strconv.Itoa(appID) + "/policies/" + strconv.Itoa(policyID)
+
+	err := s.client.RestInternal("DELETE", url, nil, nil)
+	if err != nil {
+		if fmt.Sprintf("%s", err) == "EOF" { // successful call returns EOF error -> empty body
+			return nil
+		}
+		return err
+	}
+
+	return nil
+}
+
+// ActionService intermediates Action requests
+type ActionService service
+
+// GetActions obtains all actions of an application
+func (s *ActionService) GetActions(appID int) ([]*Action, error) {
+
+	url := "controller/alerting/rest/v1/applications/" + strconv.Itoa(appID) + "/actions"
+
+	var actions []*Action
+	err := s.client.Rest("GET", url, &actions, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return actions, nil
+}
+
+// GetActionDetails obtains a single action of an application
+func (s *ActionService) GetActionDetails(appID int, actionID int) (ActionDetail, error) {
+
+	url := "controller/alerting/rest/v1/applications/" + strconv.Itoa(appID) + "/actions/" + strconv.Itoa(actionID)
+
+	var action ActionDetail
+	err := s.client.Rest("GET", url, &action, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return action, nil
+}
+
+// CreateAction - create action for an application
+func (s *ActionService) CreateAction(appID int, action ActionDetail) error {
+
+	url := "controller/alerting/rest/v1/applications/" + strconv.Itoa(appID) + "/actions"
+
+	err := s.client.RestInternal("POST", url, nil, action)
+	if err != nil {
+		if fmt.Sprintf("%s", err) == "EOF" { // successful call returns EOF error -> empty body
+			return nil
+		}
+		return err
+	}
+
+	return nil
+}
+
+// UpdateAction - update an action of an application
+func (s *ActionService) UpdateAction(appID int, actionID int, action ActionDetail) error {
+
+	url := "controller/alerting/rest/v1/applications/" + strconv.Itoa(appID) + "/actions/" + strconv.Itoa(actionID)
+
+	err := s.client.RestInternal("PUT", url, nil, action)
+	if err != nil {
+		if fmt.Sprintf("%s", err) == "EOF" { // successful call returns EOF error -> empty body
+			return nil
+		}
+		return err
+	}
+
+	return nil
+}
+
+// DeleteAction - delete an action of an application
+func (s *ActionService) DeleteAction(appID int, actionID int) error {
+
+	url := "controller/alerting/rest/v1/applications/" + strconv.Itoa(appID) + "/actions/" + strconv.Itoa(actionID)
+
+	err := s.client.RestInternal("DELETE", url, nil, nil)
+	if err != nil {
+		if fmt.Sprintf("%s", err) == "EOF" { // successful call returns EOF error -> empty body
+			return nil
+		}
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,82 @@
+/*
+MIT License
+
+Copyright (c) 2026 Cisco Systems, Inc. and its affiliates
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+
+*/
+
+package series
+
+import (
+	"math"
+	"time"
+)
+
+// Comparison is the difference between two aggregated periods
+type Comparison struct {
+	Current      float64
+	Previous     float64
+	Delta        float64
+	DeltaPercent float64 // NaN when Previous is zero
+}
+
+// PointComparison compares one point with the point one period earlier
+type PointComparison struct {
+	Time time.Time
+	Comparison
+}
+
+func compare(current float64, previous float64) Comparison {
+	c := Comparison{Current: current, Previous: previous, Delta: current - previous, DeltaPercent: math.NaN()}
+	if previous != 0 && !math.IsNaN(previous) {
+		c.DeltaPercent = c.Delta / math.Abs(previous) * 100
+	}
+	return c
+}
+
+// Compare aggregates both series with agg and compares the results
+func Compare(current *Series, previous *Series, agg Aggregation) Comparison {
+	return compare(current.Aggregate(agg), previous.Aggregate(agg))
+}
+
+// CompareValues compares two values aggregated by the caller, e.g. call weighted averages
+func CompareValues(current float64, previous float64) Comparison {
+	return compare(current, previous)
+}
+
+// PeriodOverPeriod compares every point of current with the point of previous one period earlier,
+// e.g. a period of 7*24*time.Hour for week-over-week. Points without counterpart are skipped.
+func PeriodOverPeriod(current *Series, previous *Series, period time.Duration) []PointComparison {
+	shifted := make(map[int64]float64, len(previous.Points))
+	for _, p := range previous.Points {
+		shifted[p.Time.Add(period).UnixNano()] = p.Value
+	}
+
+	var comparisons []PointComparison
+	for _, p := range current.Points {
+		prev, ok := shifted[p.Time.UnixNano()]
+		if !ok {
+			continue
+		}
+		comparisons = append(comparisons, PointComparison{Time: p.Time, Comparison: compare(p.Value, prev)})
+	}
+	return comparisons
+}
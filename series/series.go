@@ -0,0 +1,262 @@
+/*
+MIT License
+
+Copyright (c) 2026 Cisco Systems, Inc. and its affiliates
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+
+*/
+
+// Package series provides time-series helpers over appdrest.MetricData:
+// resampling, gap filling, merging, statistics and period-over-period comparison.
+package series
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	appdrest "github.com/cisco-open/appd-client-go"
+)
+
+// Point is a single value of a Series, NaN marks a missing value
+type Point struct {
+	Time  time.Time
+	Value float64
+}
+
+// Series is a list of points for one metric path, ordered by time
+type Series struct {
+	Path   string
+	Points []Point
+}
+
+// Field selects the value of a MetricValue that is used for a Series
+type Field func(v appdrest.MetricValue) float64
+
+// Fields of MetricValue usable with FromMetricDataField
+var (
+	FieldValue   Field = func(v appdrest.MetricValue) float64 { return float64(v.Value) }
+	FieldCurrent Field = func(v appdrest.MetricValue) float64 { return float64(v.Current) }
+	FieldMin     Field = func(v appdrest.MetricValue) float64 { return float64(v.Min) }
+	FieldMax     Field = func(v appdrest.MetricValue) float64 { return float64(v.Max) }
+	FieldSum     Field = func(v appdrest.MetricValue) float64 { return float64(v.Sum) }
+	FieldCount   Field = func(v appdrest.MetricValue) float64 { return float64(v.Count) }
+)
+
+// FromMetricData converts every MetricData to a Series of its Value field
+func FromMetricData(metrics []*appdrest.MetricData) []*Series {
+	return FromMetricDataField(metrics, FieldValue)
+}
+
+// FromMetricDataField converts every MetricData to a Series of the selected field
+func FromMetricDataField(metrics []*appdrest.MetricData, field Field) []*Series {
+	var all []*Series
+	for _, metric := range metrics {
+		if metric == nil {
+			continue
+		}
+		all = append(all, FromMetric(metric, field))
+	}
+	return all
+}
+
+// FromMetric converts a single MetricData to a Series of the selected field
+func FromMetric(metric *appdrest.MetricData, field Field) *Series {
+	s := &Series{Path: metric.MetricPath}
+	for _, value := range metric.MetricValues {
+		s.Points = append(s.Points, Point{Time: time.UnixMilli(value.StartTimeInMillis), Value: field(value)})
+	}
+	s.sort()
+	return s
+}
+
+func (s *Series) sort() {
+	sort.SliceStable(s.Points, func(i, j int) bool { return s.Points[i].Time.Before(s.Points[j].Time) })
+}
+
+// Values returns the values of all points that are not NaN
+func (s *Series) Values() []float64 {
+	values := make([]float64, 0, len(s.Points))
+	for _, p := range s.Points {
+		if !math.IsNaN(p.Value) {
+			values = append(values, p.Value)
+		}
+	}
+	return values
+}
+
+// Between returns the points with from <= time < to
+func (s *Series) Between(from time.Time, to time.Time) *Series {
+	out := &Series{Path: s.Path}
+	for _, p := range s.Points {
+		if !p.Time.Before(from) && p.Time.Before(to) {
+			out.Points = append(out.Points, p)
+		}
+	}
+	return out
+}
+
+// Shift moves every point by d, e.g. Shift(7*24*time.Hour) aligns last week with this week
+func (s *Series) Shift(d time.Duration) *Series {
+	out := &Series{Path: s.Path, Points: make([]Point, len(s.Points))}
+	for i, p := range s.Points {
+		out.Points[i] = Point{Time: p.Time.Add(d), Value: p.Value}
+	}
+	return out
+}
+
+// Resample groups points into buckets of step aligned to the Unix epoch and aggregates each bucket.
+// Buckets without points are omitted, use Fill to add them.
+func (s *Series) Resample(step time.Duration, agg Aggregation) *Series {
+	out := &Series{Path: s.Path}
+	if step <= 0 {
+		out.Points = append(out.Points, s.Points...)
+		return out
+	}
+
+	var bucket time.Time
+	var values []float64
+	for i, p := range s.Points {
+		t := align(p.Time, step)
+		if i > 0 && !t.Equal(bucket) {
+			out.Points = append(out.Points, Point{Time: bucket, Value: agg(values)})
+			values = values[:0]
+		}
+		bucket = t
+		if !math.IsNaN(p.Value) {
+			values = append(values, p.Value)
+		}
+	}
+	if len(s.Points) > 0 {
+		out.Points = append(out.Points, Point{Time: bucket, Value: agg(values)})
+	}
+	return out
+}
+
+// align returns the start of the bucket of step containing t, counted from the Unix epoch.
+// time.Truncate counts from year 1, which misaligns steps that do not divide a day, e.g. weeks or 7 minutes.
+func align(t time.Time, step time.Duration) time.Time {
+	ns := t.UnixNano()
+	offset := ns % int64(step)
+	if offset < 0 {
+		offset += int64(step)
+	}
+	return time.Unix(0, ns-offset).In(t.Location())
+}
+
+// FillPolicy decides the value of points added by Fill
+type FillPolicy int
+
+// Consts for the supported fill policies
+const (
+	FillNaN FillPolicy = iota
+	FillZero
+	FillPrevious
+	FillLinear
+)
+
+// Fill adds the missing points between the first and the last point every step.
+// The series is expected to be aligned to step, e.g. the result of Resample.
+func (s *Series) Fill(step time.Duration, policy FillPolicy) *Series {
+	out := &Series{Path: s.Path}
+	if step <= 0 || len(s.Points) == 0 {
+		out.Points = append(out.Points, s.Points...)
+		return out
+	}
+
+	out.Points = append(out.Points, s.Points[0])
+	for _, p := range s.Points[1:] {
+		prev := out.Points[len(out.Points)-1]
+		for t := prev.Time.Add(step); t.Before(p.Time); t = t.Add(step) {
+			out.Points = append(out.Points, Point{Time: t, Value: fillValue(policy, prev, p, t)})
+		}
+		out.Points = append(out.Points, p)
+	}
+	return out
+}
+
+func fillValue(policy FillPolicy, prev Point, next Point, t time.Time) float64 {
+	switch policy {
+	case FillZero:
+		return 0
+	case FillPrevious:
+		return prev.Value
+	case FillLinear:
+		span := next.Time.Sub(prev.Time)
+		if span <= 0 {
+			return prev.Value
+		}
+		ratio := float64(t.Sub(prev.Time)) / float64(span)
+		return prev.Value + (next.Value-prev.Value)*ratio
+	}
+	return math.NaN()
+}
+
+// Rate returns the change between consecutive points per duration,
+// useful for counters such as call totals
+func (s *Series) Rate(per time.Duration) *Series {
+	out := &Series{Path: s.Path}
+	for i := 1; i < len(s.Points); i++ {
+		prev, p := s.Points[i-1], s.Points[i]
+		elapsed := p.Time.Sub(prev.Time)
+		if elapsed <= 0 {
+			continue
+		}
+		out.Points = append(out.Points, Point{Time: p.Time, Value: (p.Value - prev.Value) * float64(per) / float64(elapsed)})
+	}
+	return out
+}
+
+// Merge combines series point by point, points with the same time are aggregated with agg
+func Merge(path string, all []*Series, agg Aggregation) *Series {
+	byTime := make(map[int64][]float64)
+	for _, s := range all {
+		for _, p := range s.Points {
+			key := p.Time.UnixNano()
+			if math.IsNaN(p.Value) {
+				if _, ok := byTime[key]; !ok {
+					byTime[key] = nil
+				}
+				continue
+			}
+			byTime[key] = append(byTime[key], p.Value)
+		}
+	}
+
+	out := &Series{Path: path}
+	for key, values := range byTime {
+		out.Points = append(out.Points, Point{Time: time.Unix(0, key), Value: agg(values)})
+	}
+	out.sort()
+	return out
+}
+
+// MergeMatching merges all series whose path matches pattern, e.g.
+// "Overall Application Performance|web|Individual Nodes|*|Calls per Minute" across all nodes.
+// The pattern is used as path of the merged series.
+func MergeMatching(all []*Series, pattern string, agg Aggregation) *Series {
+	var matched []*Series
+	for _, s := range all {
+		if appdrest.ParseMetricPath(s.Path).Match(pattern) {
+			matched = append(matched, s)
+		}
+	}
+	return Merge(pattern, matched, agg)
+}
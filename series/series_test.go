@@ -0,0 +1,83 @@
+/*
+MIT License
+
+Copyright (c) 2026 Cisco Systems, Inc. and its affiliates
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+
+*/
+
+package series
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestResampleEpochAligned(t *testing.T) {
+	// Thursday 1970-01-01 starts the weekly buckets, time.Truncate would start them on Mondays
+	thursday := time.Date(2026, 10, 15, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name  string
+		step  time.Duration
+		times []time.Time
+		want  []time.Time
+	}{
+		{"week", 7 * 24 * time.Hour,
+			[]time.Time{thursday.Add(-time.Hour), thursday, thursday.Add(4 * 24 * time.Hour)},
+			[]time.Time{thursday.Add(-7 * 24 * time.Hour), thursday}},
+		{"7 minutes", 7 * time.Minute,
+			[]time.Time{time.Unix(7*60-1, 0), time.Unix(7*60, 0), time.Unix(14*60-1, 0)},
+			[]time.Time{time.Unix(0, 0), time.Unix(7*60, 0)}},
+		{"before epoch", time.Hour,
+			[]time.Time{time.Unix(-1, 0), time.Unix(0, 0)},
+			[]time.Time{time.Unix(-3600, 0), time.Unix(0, 0)}},
+	}
+	for _, test := range tests {
+		s := &Series{}
+		for _, at := range test.times {
+			s.Points = append(s.Points, Point{Time: at, Value: 1})
+		}
+		out := s.Resample(test.step, Count)
+		if len(out.Points) != len(test.want) {
+			t.Errorf("%s: %d buckets, want %d: %v", test.name, len(out.Points), len(test.want), out.Points)
+			continue
+		}
+		for i, p := range out.Points {
+			if !p.Time.Equal(test.want[i]) {
+				t.Errorf("%s: bucket %d starts %v, want %v", test.name, i, p.Time, test.want[i])
+			}
+		}
+	}
+}
+
+func TestResampleAggregates(t *testing.T) {
+	start := time.Unix(0, 0).Add(1000 * time.Hour)
+	s := &Series{Path: "a|b", Points: []Point{
+		{start, 1},
+		{start.Add(time.Minute), math.NaN()},
+		{start.Add(4 * time.Minute), 3},
+		{start.Add(5 * time.Minute), 10},
+	}}
+	out := s.Resample(5*time.Minute, Avg)
+	if out.Path != "a|b" || len(out.Points) != 2 || out.Points[0].Value != 2 || out.Points[1].Value != 10 {
+		t.Errorf("resampled %+v", out)
+	}
+}
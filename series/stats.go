@@ -0,0 +1,151 @@
+/*
+MIT License
+
+Copyright (c) 2026 Cisco Systems, Inc. and its affiliates
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+
+*/
+
+package series
+
+import (
+	"math"
+	"sort"
+)
+
+// Aggregation reduces a list of values to one, it returns NaN for no values
+type Aggregation func(values []float64) float64
+
+// Aggregations usable with Resample and Merge
+var (
+	Sum   Aggregation = sum
+	Avg   Aggregation = Mean
+	Min   Aggregation = minimum
+	Max   Aggregation = maximum
+	Count Aggregation = func(values []float64) float64 { return float64(len(values)) }
+	Last  Aggregation = func(values []float64) float64 {
+		if len(values) == 0 {
+			return math.NaN()
+		}
+		return values[len(values)-1]
+	}
+)
+
+// PercentileAggregation returns an Aggregation computing the p-th percentile
+func PercentileAggregation(p float64) Aggregation {
+	return func(values []float64) float64 { return Percentile(values, p) }
+}
+
+func sum(values []float64) float64 {
+	if len(values) == 0 {
+		return math.NaN()
+	}
+	total := 0.0
+	for _, v := range values {
+		total += v
+	}
+	return total
+}
+
+func minimum(values []float64) float64 {
+	if len(values) == 0 {
+		return math.NaN()
+	}
+	m := values[0]
+	for _, v := range values[1:] {
+		if v < m {
+			m = v
+		}
+	}
+	return m
+}
+
+func maximum(values []float64) float64 {
+	if len(values) == 0 {
+		return math.NaN()
+	}
+	m := values[0]
+	for _, v := range values[1:] {
+		if v > m {
+			m = v
+		}
+	}
+	return m
+}
+
+// Mean returns the arithmetic mean
+func Mean(values []float64) float64 {
+	if len(values) == 0 {
+		return math.NaN()
+	}
+	return sum(values) / float64(len(values))
+}
+
+// StdDev returns the population standard deviation
+func StdDev(values []float64) float64 {
+	if len(values) == 0 {
+		return math.NaN()
+	}
+	mean := Mean(values)
+	variance := 0.0
+	for _, v := range values {
+		variance += (v - mean) * (v - mean)
+	}
+	return math.Sqrt(variance / float64(len(values)))
+}
+
+// Percentile returns the p-th percentile (0-100) with linear interpolation between closest ranks
+func Percentile(values []float64, p float64) float64 {
+	if len(values) == 0 {
+		return math.NaN()
+	}
+	sorted := append([]float64(nil), values...)
+	sort.Float64s(sorted)
+	if p <= 0 {
+		return sorted[0]
+	}
+	if p >= 100 {
+		return sorted[len(sorted)-1]
+	}
+	rank := p / 100 * float64(len(sorted)-1)
+	lower := int(math.Floor(rank))
+	upper := int(math.Ceil(rank))
+	return sorted[lower] + (sorted[upper]-sorted[lower])*(rank-float64(lower))
+}
+
+// Mean returns the mean of the series values
+func (s *Series) Mean() float64 {
+	return Mean(s.Values())
+}
+
+// StdDev returns the standard deviation of the series values
+func (s *Series) StdDev() float64 {
+	return StdDev(s.Values())
+}
+
+// Percentile returns the p-th percentile of the series values
+func (s *Series) Percentile(p float64) float64 {
+	return Percentile(s.Values(), p)
+}
+
+// Aggregate reduces the series values with agg
+func (s *Series) Aggregate(agg Aggregation) float64 {
+	return agg(s.Values())
+}
@@ -0,0 +1,297 @@
+/*
+MIT License
+
+Copyright (c) 2026 Cisco Systems, Inc. and its affiliates
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+
+*/
+
+// Package slo computes service level objective attainment, error budgets and
+// multi-window burn rates of Business Transactions from AppDynamics metrics.
+package slo
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	appdrest "github.com/cisco-open/appd-client-go"
+)
+
+// Kind is the type of an Objective
+type Kind string
+
+// Consts for the supported objective kinds
+const (
+	Availability Kind = "AVAILABILITY" // good calls are calls without error
+	Latency      Kind = "LATENCY"      // good calls are calls below the latency threshold
+)
+
+// LatencyThreshold selects which BT user experience counts as too slow
+type LatencyThreshold string
+
+// Consts for the latency thresholds, they follow the BT slow and very slow thresholds of the controller
+const (
+	SlowCalls     LatencyThreshold = "SLOW"      // slow, very slow calls are bad
+	VerySlowCalls LatencyThreshold = "VERY_SLOW" // only very slow calls are bad
+)
+
+// Objective defines one SLO of a Business Transaction
+type Objective struct {
+	Name             string
+	Kind             Kind
+	Target           float64       // percentage of good calls, e.g. 99.9
+	Window           time.Duration // compliance window, e.g. 30 * 24 * time.Hour
+	LatencyThreshold LatencyThreshold
+}
+
+// Counts are the call totals of a BT for a time window
+type Counts struct {
+	Calls    float64
+	Errors   float64
+	Slow     float64
+	VerySlow float64
+}
+
+// Bad returns the number of calls not meeting the objective
+func (c Counts) Bad(o Objective) float64 {
+	if o.Kind == Latency {
+		if o.LatencyThreshold == VerySlowCalls {
+			return c.VerySlow
+		}
+		return c.Slow + c.VerySlow
+	}
+	return c.Errors
+}
+
+// BurnRate is the error budget consumption speed over a window, 1 spends the budget exactly in the SLO window
+type BurnRate struct {
+	Window time.Duration
+	Calls  float64
+	Bad    float64
+	Rate   float64
+}
+
+// Report is the SLO evaluation of one Business Transaction
+type Report struct {
+	Application         string
+	BusinessTransaction *appdrest.BusinessTransaction
+	Objective           Objective
+	From                time.Time
+	To                  time.Time
+	Calls               float64
+	Bad                 float64
+	Attainment          float64 // percentage of good calls, 100 without calls
+	ErrorBudget         float64 // number of bad calls allowed in the window
+	BudgetRemaining     float64 // percentage of the error budget left, negative when exhausted
+	Met                 bool
+	BurnRates           []BurnRate
+}
+
+// BurnRateAlert fires when the burn rate of both windows exceeds Threshold
+type BurnRateAlert struct {
+	Name        string
+	Severity    string // event severity, INFO, WARN or ERROR
+	LongWindow  time.Duration
+	ShortWindow time.Duration
+	Threshold   float64
+}
+
+// DefaultBurnRateAlerts are the multi-window alerts recommended for a 30 day window
+var DefaultBurnRateAlerts = []BurnRateAlert{
+	{Name: "fast-burn", Severity: "ERROR", LongWindow: time.Hour, ShortWindow: 5 * time.Minute, Threshold: 14.4},
+	{Name: "medium-burn", Severity: "ERROR", LongWindow: 6 * time.Hour, ShortWindow: 30 * time.Minute, Threshold: 6},
+	{Name: "slow-burn", Severity: "WARN", LongWindow: 72 * time.Hour, ShortWindow: 6 * time.Hour, Threshold: 1},
+}
+
+// DefaultBurnRateWindows are the windows needed by DefaultBurnRateAlerts
+var DefaultBurnRateWindows = []time.Duration{5 * time.Minute, 30 * time.Minute, time.Hour, 6 * time.Hour, 72 * time.Hour}
+
+// NewReport computes a report from call counts of the SLO window and of the burn rate windows
+func NewReport(o Objective, total Counts, windows map[time.Duration]Counts) *Report {
+	r := &Report{
+		Objective:   o,
+		Calls:       total.Calls,
+		Bad:         total.Bad(o),
+		Attainment:  100,
+		ErrorBudget: total.Calls * (100 - o.Target) / 100,
+	}
+	if r.Calls > 0 {
+		r.Attainment = (r.Calls - r.Bad) / r.Calls * 100
+	}
+	r.BudgetRemaining = 100
+	if r.ErrorBudget > 0 {
+		r.BudgetRemaining = (r.ErrorBudget - r.Bad) / r.ErrorBudget * 100
+	} else if r.Bad > 0 {
+		r.BudgetRemaining = math.Inf(-1)
+	}
+	r.Met = r.Attainment >= o.Target
+
+	allowed := (100 - o.Target) / 100
+	for window, counts := range windows {
+		rate := BurnRate{Window: window, Calls: counts.Calls, Bad: counts.Bad(o)}
+		if counts.Calls > 0 && allowed > 0 {
+			rate.Rate = rate.Bad / counts.Calls / allowed
+		}
+		r.BurnRates = append(r.BurnRates, rate)
+	}
+	sort.Slice(r.BurnRates, func(i, j int) bool { return r.BurnRates[i].Window < r.BurnRates[j].Window })
+	return r
+}
+
+// BurnRate returns the burn rate of a window, false when the window was not evaluated
+func (r *Report) BurnRate(window time.Duration) (BurnRate, bool) {
+	for _, rate := range r.BurnRates {
+		if rate.Window == window {
+			return rate, true
+		}
+	}
+	return BurnRate{}, false
+}
+
+// FiringAlerts returns the alerts whose long and short window burn rates both exceed the threshold
+func (r *Report) FiringAlerts(alerts []BurnRateAlert) []BurnRateAlert {
+	var firing []BurnRateAlert
+	for _, alert := range alerts {
+		long, okLong := r.BurnRate(alert.LongWindow)
+		short, okShort := r.BurnRate(alert.ShortWindow)
+		if okLong && okShort && long.Rate > alert.Threshold && short.Rate > alert.Threshold {
+			firing = append(firing, alert)
+		}
+	}
+	return firing
+}
+
+// String returns a one line summary of the report
+func (r *Report) String() string {
+	name := r.Objective.Name
+	if r.BusinessTransaction != nil {
+		name = fmt.Sprintf("%s/%s %s", r.BusinessTransaction.TierName, r.BusinessTransaction.Name, name)
+	}
+	var rates []string
+	for _, rate := range r.BurnRates {
+		rates = append(rates, fmt.Sprintf("%v=%.2f", rate.Window, rate.Rate))
+	}
+	return fmt.Sprintf("%s: attainment %.3f%% (target %.3f%%), budget remaining %.1f%%, burn rates [%s]",
+		name, r.Attainment, r.Objective.Target, r.BudgetRemaining, strings.Join(rates, " "))
+}
+
+// Calculator fetches BT metrics and evaluates objectives
+type Calculator struct {
+	MetricData      *appdrest.MetricDataService
+	BurnRateWindows []time.Duration // defaults to DefaultBurnRateWindows
+}
+
+// NewCalculator returns a Calculator using the metric service of the client
+func NewCalculator(client *appdrest.Client) *Calculator {
+	return &Calculator{MetricData: client.MetricData}
+}
+
+// Evaluate computes a report per objective for a BT ending at the current minute
+func (c *Calculator) Evaluate(application string, bt *appdrest.BusinessTransaction, objectives ...Objective) ([]*Report, error) {
+	return c.EvaluateAt(application, bt, time.Now(), objectives...)
+}
+
+// EvaluateAt computes a report per objective for a BT ending at the minute of end
+func (c *Calculator) EvaluateAt(application string, bt *appdrest.BusinessTransaction, end time.Time, objectives ...Objective) ([]*Report, error) {
+	end = end.Truncate(time.Minute)
+	windows := c.BurnRateWindows
+	if windows == nil {
+		windows = DefaultBurnRateWindows
+	}
+
+	cache := make(map[time.Duration]Counts)
+	counts := func(window time.Duration) (Counts, error) {
+		if cached, ok := cache[window]; ok {
+			return cached, nil
+		}
+		fetched, err := c.Counts(application, bt, end.Add(-window), end)
+		if err != nil {
+			return Counts{}, err
+		}
+		cache[window] = fetched
+		return fetched, nil
+	}
+
+	var reports []*Report
+	for _, o := range objectives {
+		if o.Window <= 0 {
+			return nil, fmt.Errorf("SLO %s: window must be positive", o.Name)
+		}
+		if o.Target <= 0 || o.Target >= 100 {
+			return nil, fmt.Errorf("SLO %s: target must be between 0 and 100, got %v", o.Name, o.Target)
+		}
+
+		total, err := counts(o.Window)
+		if err != nil {
+			return nil, err
+		}
+		burn := make(map[time.Duration]Counts)
+		for _, window := range windows {
+			if window > o.Window {
+				continue
+			}
+			burn[window], err = counts(window)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		r := NewReport(o, total, burn)
+		r.Application = application
+		r.BusinessTransaction = bt
+		r.From = end.Add(-o.Window)
+		r.To = end
+		reports = append(reports, r)
+	}
+
+	return reports, nil
+}
+
+// Counts fetches the BT call totals between two times with a single rolled up wildcard query
+func (c *Calculator) Counts(application string, bt *appdrest.BusinessTransaction, from time.Time, to time.Time) (Counts, error) {
+	path := appdrest.BusinessTransactionMetricPath(bt.TierName, bt.Name, appdrest.MetricPathWildcard)
+
+	metrics, err := c.MetricData.GetMetricData(application, path.String(), true, appdrest.TimeBETWEENTIMES, 0, from, to)
+	if err != nil {
+		return Counts{}, err
+	}
+
+	var counts Counts
+	for _, metric := range metrics {
+		var total float64
+		for _, value := range metric.MetricValues {
+			total += float64(value.Sum)
+		}
+		switch metric.Path().Name() {
+		case appdrest.MetricCallsPerMinute:
+			counts.Calls = total
+		case appdrest.MetricErrorsPerMinute:
+			counts.Errors = total
+		case appdrest.MetricSlowCalls:
+			counts.Slow = total
+		case appdrest.MetricVerySlowCalls:
+			counts.VerySlow = total
+		}
+	}
+	return counts, nil
+}
@@ -0,0 +1,194 @@
+/*
+MIT License
+
+Copyright (c) 2026 Cisco Systems, Inc. and its affiliates
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+
+*/
+
+package slo
+
+import (
+	"encoding/json"
+	"math"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	appdrest "github.com/cisco-open/appd-client-go"
+	"github.com/cisco-open/appd-client-go/internal/apptest"
+)
+
+func near(a float64, b float64) bool {
+	return math.Abs(a-b) < 1e-9 || (math.IsInf(a, -1) && math.IsInf(b, -1))
+}
+
+func TestCountsBad(t *testing.T) {
+	counts := Counts{Calls: 100, Errors: 3, Slow: 5, VerySlow: 2}
+	tests := []struct {
+		objective Objective
+		want      float64
+	}{
+		{Objective{Kind: Availability}, 3},
+		{Objective{Kind: Latency, LatencyThreshold: SlowCalls}, 7},
+		{Objective{Kind: Latency}, 7},
+		{Objective{Kind: Latency, LatencyThreshold: VerySlowCalls}, 2},
+	}
+	for _, test := range tests {
+		if got := counts.Bad(test.objective); got != test.want {
+			t.Errorf("%s %s: bad %v, want %v", test.objective.Kind, test.objective.LatencyThreshold, got, test.want)
+		}
+	}
+}
+
+func TestNewReport(t *testing.T) {
+	availability := Objective{Name: "availability", Kind: Availability, Target: 99.9, Window: 30 * 24 * time.Hour}
+	tests := []struct {
+		name            string
+		total           Counts
+		attainment      float64
+		errorBudget     float64
+		budgetRemaining float64
+		met             bool
+	}{
+		{"half the budget", Counts{Calls: 10000, Errors: 5}, 99.95, 10, 50, true},
+		{"budget exhausted", Counts{Calls: 10000, Errors: 20}, 99.8, 10, -100, false},
+		{"exactly on target", Counts{Calls: 10000, Errors: 10}, 99.9, 10, 0, true},
+		{"no calls", Counts{}, 100, 0, 100, true},
+	}
+	for _, test := range tests {
+		r := NewReport(availability, test.total, nil)
+		if !near(r.Attainment, test.attainment) || !near(r.ErrorBudget, test.errorBudget) || !near(r.BudgetRemaining, test.budgetRemaining) || r.Met != test.met {
+			t.Errorf("%s: attainment %v, budget %v, remaining %v, met %t, want %v, %v, %v, %t", test.name,
+				r.Attainment, r.ErrorBudget, r.BudgetRemaining, r.Met, test.attainment, test.errorBudget, test.budgetRemaining, test.met)
+		}
+	}
+}
+
+func TestBurnRates(t *testing.T) {
+	o := Objective{Kind: Availability, Target: 99.9, Window: 30 * 24 * time.Hour}
+	r := NewReport(o, Counts{Calls: 1000000, Errors: 600}, map[time.Duration]Counts{
+		time.Hour:        {Calls: 1000, Errors: 15},
+		5 * time.Minute:  {Calls: 100, Errors: 2},
+		6 * time.Hour:    {Calls: 6000, Errors: 12},
+		30 * time.Minute: {Calls: 500, Errors: 0},
+		72 * time.Hour:   {},
+	})
+
+	want := []BurnRate{
+		{Window: 5 * time.Minute, Calls: 100, Bad: 2, Rate: 20},
+		{Window: 30 * time.Minute, Calls: 500, Bad: 0, Rate: 0},
+		{Window: time.Hour, Calls: 1000, Bad: 15, Rate: 15},
+		{Window: 6 * time.Hour, Calls: 6000, Bad: 12, Rate: 2},
+		{Window: 72 * time.Hour, Calls: 0, Bad: 0, Rate: 0},
+	}
+	if len(r.BurnRates) != len(want) {
+		t.Fatalf("burn rates %+v", r.BurnRates)
+	}
+	for i, rate := range r.BurnRates {
+		if rate.Window != want[i].Window || rate.Calls != want[i].Calls || rate.Bad != want[i].Bad || !near(rate.Rate, want[i].Rate) {
+			t.Errorf("burn rate %+v, want %+v", rate, want[i])
+		}
+	}
+
+	firing := r.FiringAlerts(DefaultBurnRateAlerts)
+	if len(firing) != 1 || firing[0].Name != "fast-burn" {
+		t.Errorf("firing alerts %+v, want fast-burn", firing)
+	}
+	if firing := r.FiringAlerts([]BurnRateAlert{{Name: "missing window", LongWindow: 24 * time.Hour, ShortWindow: time.Hour}}); len(firing) != 0 {
+		t.Errorf("alert on a window that was not evaluated fired: %+v", firing)
+	}
+}
+
+// sloController answers BT metric queries with calls, errors, slow and very slow calls proportional to the window
+type sloController struct {
+	t        *testing.T
+	requests int
+}
+
+func (c *sloController) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	c.requests++
+	query := r.URL.Query()
+	from, _ := strconv.ParseInt(query.Get("start-time"), 10, 64)
+	to, _ := strconv.ParseInt(query.Get("end-time"), 10, 64)
+	minutes := int((to - from) / time.Minute.Milliseconds())
+	if query.Get("rollup") != "true" || query.Get("metric-path") != "Business Transaction Performance|Business Transactions|web|/pay|*" {
+		c.t.Errorf("unexpected query %s", r.URL.RawQuery)
+	}
+
+	var metrics []appdrest.MetricData
+	for name, perMinute := range map[string]int{
+		appdrest.MetricCallsPerMinute:  1000,
+		appdrest.MetricErrorsPerMinute: 2,
+		appdrest.MetricSlowCalls:       3,
+		appdrest.MetricVerySlowCalls:   1,
+		"Average Response Time (ms)":   120,
+	} {
+		path := appdrest.BusinessTransactionMetricPath("web", "/pay", name)
+		metrics = append(metrics, appdrest.MetricData{MetricName: name, MetricPath: path.String(), MetricValues: []appdrest.MetricValue{{Sum: perMinute * minutes}}})
+	}
+	json.NewEncoder(w).Encode(metrics)
+}
+
+func TestEvaluateAt(t *testing.T) {
+	controller := &sloController{t: t}
+	host, port := apptest.Server(t, controller)
+	client, err := appdrest.NewClient("http", host, port, "user", "secret", "customer1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	calculator := NewCalculator(client)
+	bt := &appdrest.BusinessTransaction{TierName: "web", Name: "/pay"}
+	objectives := []Objective{
+		{Name: "availability", Kind: Availability, Target: 99.9, Window: 24 * time.Hour},
+		{Name: "latency", Kind: Latency, Target: 99.5, Window: 24 * time.Hour},
+	}
+
+	reports, err := calculator.EvaluateAt("shop", bt, time.Date(2026, 10, 19, 12, 0, 30, 0, time.UTC), objectives...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// one query per window, windows longer than the objective are skipped and all are shared between objectives
+	if controller.requests != 5 {
+		t.Errorf("%d metric queries, want 5", controller.requests)
+	}
+	if len(reports) != 2 {
+		t.Fatalf("%d reports", len(reports))
+	}
+
+	availability, latency := reports[0], reports[1]
+	if availability.Calls != 1440000 || availability.Bad != 2880 || !near(availability.Attainment, 99.8) || availability.Met {
+		t.Errorf("availability %s", availability)
+	}
+	if !latency.To.Equal(time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)) || latency.Bad != 5760 || !latency.Met {
+		t.Errorf("latency %s, to %v", latency, latency.To)
+	}
+	if rate, ok := availability.BurnRate(time.Hour); !ok || !near(rate.Rate, 2) {
+		t.Errorf("availability hourly burn rate %+v", rate)
+	}
+	if _, ok := availability.BurnRate(72 * time.Hour); ok {
+		t.Errorf("72h burn rate evaluated for a 24h objective")
+	}
+
+	if _, err := calculator.EvaluateAt("shop", bt, time.Now(), Objective{Name: "bad", Window: time.Hour, Target: 100}); err == nil {
+		t.Errorf("target of 100%% accepted")
+	}
+}
@@ -26,19 +26,73 @@ SOFTWARE.
 package appdrest
 
 import (
+	"errors"
 	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// TierType is the type of a tier as shown in the controller UI
+// Added 2026 Cisco Systems, Inc.
+type TierType string
+
+// Consts for the tier types
+// Added 2026 Cisco Systems, Inc.
+const (
+	TierApplicationServer       TierType = "Application Server"
+	TierDotNetApplicationServer TierType = ".NET Application Server"
+	TierNodeJSServer            TierType = "Node.JS Server"
+	TierPHPApplicationServer    TierType = "PHP Application Server"
+	TierPythonServer            TierType = "Python Server"
+	TierWebServer               TierType = "Web Server"
+	TierNativeServer            TierType = "Native Server"
+	TierGolangServer            TierType = "Golang Server"
+)
+
+// AgentType is the type of agent reporting for a tier or node
+// Added 2026 Cisco Systems, Inc.
+type AgentType string
+
+// Consts for the agent types
+// Added 2026 Cisco Systems, Inc.
+const (
+	AgentJava              AgentType = "APP_AGENT"
+	AgentDotNet            AgentType = "DOT_NET_APP_AGENT"
+	AgentNodeJS            AgentType = "NODEJS_APP_AGENT"
+	AgentPHP               AgentType = "PHP_APP_AGENT"
+	AgentPython            AgentType = "PYTHON_APP_AGENT"
+	AgentRuby              AgentType = "RUBY_APP_AGENT"
+	AgentNative            AgentType = "NATIVE_APP_AGENT"
+	AgentNativeSDK         AgentType = "NATIVE_SDK"
+	AgentGolangSDK         AgentType = "GOLANG_SDK"
+	AgentWebServer         AgentType = "NATIVE_WEB_SERVER"
+	AgentMachine           AgentType = "MACHINE_AGENT"
+	AgentDotNetMachine     AgentType = "DOT_NET_MACHINE_AGENT"
+	AgentDatabase          AgentType = "DB_AGENT"
+	AgentDatabaseCollector AgentType = "DB_COLLECTOR"
+	AgentAnalytics         AgentType = "ANALYTICS_AGENT"
+	AgentOpenTelemetry     AgentType = "OPEN_TELEMETRY"
 )
 
 // Tier represents one tier within one Application
+// Modified by 2026 Cisco Systems, Inc.
 type Tier struct {
-	AgentType     string `json:"agentType"`
-	Name          string `json:"name"`
-	Description   string `json:"description"`
-	ID            int    `json:"id"`
-	NumberOfNodes int    `json:"numberOfNodes"`
-	Type          string `json:"type"`
+	AgentType     AgentType `json:"agentType"`
+	Name          string    `json:"name"`
+	Description   string    `json:"description"`
+	ID            int       `json:"id"`
+	NumberOfNodes int       `json:"numberOfNodes"`
+	Type          TierType  `json:"type"`
 }
 
+// ErrTierNotFound is returned when a tier does not exist
+// Added 2026 Cisco Systems, Inc.
+var ErrTierNotFound = errors.New("tier not found")
+
+// ErrTierNotEmpty is returned when deleting a tier that still has nodes
+// Added 2026 Cisco Systems, Inc.
+var ErrTierNotEmpty = errors.New("tier has nodes")
+
 // TierService intermediates Tier requests
 type TierService service
 
@@ -55,3 +109,104 @@ func (s *TierService) GetTiers(appID int) ([]*Tier, error) {
 
 	return tiers, nil
 }
+
+// GetTier obtains a single Tier of an Application by name or ID,
+// ErrTierNotFound is returned when there is none
+// Added 2026 Cisco Systems, Inc.
+func (s *TierService) GetTier(appID int, tierNameOrID string) (*Tier, error) {
+
+	tiers, err := s.GetTiers(appID)
+	if err != nil {
+		return nil, err
+	}
+
+	id, idErr := strconv.Atoi(tierNameOrID)
+	for _, tier := range tiers {
+		if tier.Name == tierNameOrID || (idErr == nil && tier.ID == id) {
+			return tier, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w: %s in application %d", ErrTierNotFound, tierNameOrID, appID)
+}
+
+// GetTierNodes obtains all Nodes of a Tier by tier name or ID
+// Added 2026 Cisco Systems, Inc.
+func (s *TierService) GetTierNodes(appID int, tierNameOrID string) ([]*Node, error) {
+
+	nodesUrl := fmt.Sprintf("controller/rest/applications/%d/tiers/%s/nodes?output=json", appID, url.PathEscape(tierNameOrID))
+
+	var nodes []*Node
+	err := s.client.Rest("GET", nodesUrl, &nodes, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return nodes, nil
+}
+
+// DANGER ZONE
+// following types are used for UNPUBLISHED api call and it may change in the future
+type tierDetails struct {
+	Name          string   `json:"name"`
+	Description   string   `json:"description"`
+	ComponentType TierType `json:"componentType"`
+}
+
+// DANGER ZONE END
+
+// DANGER ZONE
+// this is an UNPUBLISHED API call - it may change in the future
+
+// CreateTier creates a tier before any agent registers for it,
+// e.g. for .NET and Node.js agents that must be configured with an existing tier
+// Added 2026 Cisco Systems, Inc.
+func (s *TierService) CreateTier(appID int, name string, description string, tierType TierType) (*Tier, error) {
+
+	if _, err := s.GetTier(appID, name); err == nil {
+		return nil, fmt.Errorf("tier %s already exists in application %d", name, appID)
+	} else if !errors.Is(err, ErrTierNotFound) {
+		return nil, err
+	}
+
+	url := fmt.Sprintf("controller/restui/components/createComponent?applicationId=%d", appID)
+
+	body := tierDetails{Name: name, Description: description, ComponentType: tierType}
+	var tier *Tier
+	err := s.client.RestInternal("POST", url, &tier, &body)
+	if err != nil {
+		return nil, err
+	}
+
+	if tier == nil || tier.ID == 0 {
+		return s.GetTier(appID, name)
+	}
+	return tier, nil
+}
+
+// DeleteTier deletes a tier by name or ID, ErrTierNotEmpty is returned when it still has nodes
+// Added 2026 Cisco Systems, Inc.
+func (s *TierService) DeleteTier(appID int, tierNameOrID string) error {
+
+	tier, err := s.GetTier(appID, tierNameOrID)
+	if err != nil {
+		return err
+	}
+	if tier.NumberOfNodes > 0 {
+		return fmt.Errorf("%w: %s has %d nodes", ErrTierNotEmpty, tier.Name, tier.NumberOfNodes)
+	}
+
+	url := "controller/restui/components/deleteComponents"
+
+	err = s.client.RestInternal("POST", url, nil, []int{tier.ID})
+	if err != nil {
+		if fmt.Sprintf("%s", err) == "EOF" { // successful call returns EOF error -> empty body
+			return nil
+		}
+		return err
+	}
+
+	return nil
+}
+
+// DANGER ZONE END
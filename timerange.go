@@ -106,3 +106,18 @@ func (s *TimeRangeService) UpdateTimeRange(tr TimeRange) (*TimeRange, error) {
 
 	return returnTr, nil
 }
+
+// CreateTimeRange will create a new custom Time Range
+// Added 2026 Cisco Systems, Inc.
+func (s *TimeRangeService) CreateTimeRange(tr TimeRange) (*TimeRange, error) {
+
+	url := "controller/restui/user/createCustomRange"
+
+	var returnTr *TimeRange
+	err := s.client.RestInternal("POST", url, &returnTr, &tr)
+	if err != nil {
+		return nil, err
+	}
+
+	return returnTr, nil
+}
@@ -0,0 +1,309 @@
+/*
+MIT License
+
+Copyright (c) 2026 Cisco Systems, Inc. and its affiliates
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+
+*/
+
+package appdrest
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+)
+
+// TopologyNodeType is the kind of a node of the application flow map
+type TopologyNodeType string
+
+// Consts for the topology node types
+const (
+	TopologyTier              TopologyNodeType = "TIER"
+	TopologyBackend           TopologyNodeType = "BACKEND"
+	TopologyRemoteApplication TopologyNodeType = "REMOTE_APPLICATION"
+)
+
+// TopologyStats are the flow map statistics of a node or an edge for the requested time range
+type TopologyStats struct {
+	CallsPerMinute      float64 `json:"callsPerMinute"`
+	AverageResponseTime float64 `json:"averageResponseTime"`
+	ErrorsPerMinute     float64 `json:"errorsPerMinute"`
+}
+
+// TopologyNode is a tier, backend or remote application on the flow map
+type TopologyNode struct {
+	ID       string           `json:"id"` // "<type>:<entity ID>", unique within the topology
+	EntityID int              `json:"entityId"`
+	Type     TopologyNodeType `json:"type"`
+	Name     string           `json:"name"`
+	TopologyStats
+}
+
+// TopologyEdge is a call relationship between two nodes
+type TopologyEdge struct {
+	Source string `json:"source"`
+	Target string `json:"target"`
+	TopologyStats
+}
+
+// Topology is the flow map of an application as a directed graph
+type Topology struct {
+	ApplicationID int             `json:"applicationId"`
+	Start         time.Time       `json:"start"`
+	End           time.Time       `json:"end"`
+	Nodes         []*TopologyNode `json:"nodes"`
+	Edges         []*TopologyEdge `json:"edges"`
+}
+
+// Node returns the node with the given ID or nil
+func (t *Topology) Node(id string) *TopologyNode {
+	for _, node := range t.Nodes {
+		if node.ID == id {
+			return node
+		}
+	}
+	return nil
+}
+
+// Outgoing returns the edges leaving a node
+func (t *Topology) Outgoing(id string) []*TopologyEdge {
+	var edges []*TopologyEdge
+	for _, edge := range t.Edges {
+		if edge.Source == id {
+			edges = append(edges, edge)
+		}
+	}
+	return edges
+}
+
+// Incoming returns the edges entering a node
+func (t *Topology) Incoming(id string) []*TopologyEdge {
+	var edges []*TopologyEdge
+	for _, edge := range t.Edges {
+		if edge.Target == id {
+			edges = append(edges, edge)
+		}
+	}
+	return edges
+}
+
+// WriteJSON writes the topology as indented JSON
+func (t *Topology) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(t)
+}
+
+// WriteDOT writes the topology in Graphviz DOT format
+func (t *Topology) WriteDOT(w io.Writer) error {
+	var b strings.Builder
+	fmt.Fprintf(&b, "digraph \"application %d\" {\n", t.ApplicationID)
+	b.WriteString("  rankdir=LR;\n")
+	for _, node := range t.Nodes {
+		shape := "box"
+		switch node.Type {
+		case TopologyBackend:
+			shape = "cylinder"
+		case TopologyRemoteApplication:
+			shape = "component"
+		}
+		fmt.Fprintf(&b, "  %s [label=%s, shape=%s];\n", dotQuote(node.ID), dotQuote(node.Name), shape)
+	}
+	for _, edge := range t.Edges {
+		fmt.Fprintf(&b, "  %s -> %s [label=%s];\n", dotQuote(edge.Source), dotQuote(edge.Target), dotQuote(edge.label("\n")))
+	}
+	b.WriteString("}\n")
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// WriteMermaid writes the topology as a Mermaid flowchart
+func (t *Topology) WriteMermaid(w io.Writer) error {
+	ids := make(map[string]string, len(t.Nodes))
+	var b strings.Builder
+	b.WriteString("flowchart LR\n")
+	for i, node := range t.Nodes {
+		id := fmt.Sprintf("n%d", i)
+		ids[node.ID] = id
+		name := mermaidQuote(node.Name)
+		switch node.Type {
+		case TopologyBackend:
+			fmt.Fprintf(&b, "  %s[(%s)]\n", id, name)
+		case TopologyRemoteApplication:
+			fmt.Fprintf(&b, "  %s[[%s]]\n", id, name)
+		default:
+			fmt.Fprintf(&b, "  %s[%s]\n", id, name)
+		}
+	}
+	for _, edge := range t.Edges {
+		source, target := ids[edge.Source], ids[edge.Target]
+		if source == "" || target == "" {
+			continue
+		}
+		fmt.Fprintf(&b, "  %s -->|%s| %s\n", source, mermaidQuote(edge.label(", ")), target)
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+func (e *TopologyEdge) label(sep string) string {
+	return fmt.Sprintf("%.1f cpm%s%.0f ms%s%.1f epm", e.CallsPerMinute, sep, e.AverageResponseTime, sep, e.ErrorsPerMinute)
+}
+
+// dotQuoter escapes text inside a quoted DOT ID or label,
+// backslashes are doubled so names never form label escapes like \N or \l
+var dotQuoter = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\r\n", `\n`, "\n", `\n`, "\r", `\n`)
+
+func dotQuote(s string) string {
+	return `"` + dotQuoter.Replace(s) + `"`
+}
+
+// mermaidQuoter replaces the characters with a meaning inside quoted Mermaid text by entity codes.
+// "#" is replaced as well so names can't form entity codes, "<" and ">" would be read as HTML
+// and a backtick right after the quote would start a markdown string.
+var mermaidQuoter = strings.NewReplacer("#", "#35;", `"`, "#quot;", "<", "#lt;", ">", "#gt;", "&", "#amp;", "`", "#96;", "\r\n", "<br>", "\n", "<br>", "\r", "<br>")
+
+func mermaidQuote(s string) string {
+	return `"` + mermaidQuoter.Replace(s) + `"`
+}
+
+// DANGER ZONE
+// following types are used for UNPUBLISHED api call and it may change in the future
+type flowMapMetric struct {
+	MetricValue float64 `json:"metricValue"`
+}
+
+type flowMapStats struct {
+	AverageResponseTime flowMapMetric `json:"averageResponseTime"`
+	CallsPerMinute      flowMapMetric `json:"callsPerMinute"`
+	ErrorsPerMinute     flowMapMetric `json:"errorsPerMinute"`
+}
+
+type flowMapNode struct {
+	IDNum      int          `json:"idNum"`
+	Name       string       `json:"name"`
+	EntityType string       `json:"entityType"`
+	Stats      flowMapStats `json:"stats"`
+}
+
+type flowMapNodeDefinition struct {
+	EntityType string `json:"entityType"`
+	EntityID   int    `json:"entityId"`
+}
+
+type flowMapEdge struct {
+	SourceNodeDefinition flowMapNodeDefinition `json:"sourceNodeDefinition"`
+	TargetNodeDefinition flowMapNodeDefinition `json:"targetNodeDefinition"`
+	Stats                flowMapStats          `json:"stats"`
+}
+
+type flowMapResponse struct {
+	Nodes []flowMapNode `json:"nodes"`
+	Edges []flowMapEdge `json:"edges"`
+}
+
+// DANGER ZONE END
+
+func (s flowMapStats) topology() TopologyStats {
+	return TopologyStats{
+		CallsPerMinute:      s.CallsPerMinute.MetricValue,
+		AverageResponseTime: s.AverageResponseTime.MetricValue,
+		ErrorsPerMinute:     s.ErrorsPerMinute.MetricValue,
+	}
+}
+
+// topologyNodeType translates flow map entity types
+func topologyNodeType(entityType string) TopologyNodeType {
+	switch entityType {
+	case "APPLICATION_COMPONENT":
+		return TopologyTier
+	case "BACKEND":
+		return TopologyBackend
+	case "APPLICATION":
+		return TopologyRemoteApplication
+	}
+	return TopologyNodeType(entityType)
+}
+
+func topologyNodeID(entityType string, entityID int) string {
+	return fmt.Sprintf("%s:%d", topologyNodeType(entityType), entityID)
+}
+
+// restuiTimeRange builds the time-range parameter of RESTUI calls, "name.type.end.start.duration"
+func restuiTimeRange(timeRangeType string, durationInMins int, startTime time.Time, endTime time.Time) string {
+	if timeRangeType == TimeBEFORENOW {
+		return fmt.Sprintf("last_%d_minutes.BEFORE_NOW.-1.-1.%d", durationInMins, durationInMins)
+	}
+	start, end := metricTimeRange(timeRangeType, durationInMins, startTime, endTime, time.Now())
+	return fmt.Sprintf("Custom_Time_Range.BETWEEN_TIMES.%d.%d.%d", end.UnixMilli(), start.UnixMilli(), int(end.Sub(start)/time.Minute))
+}
+
+// TopologyService intermediates flow map requests
+type TopologyService service
+
+// DANGER ZONE
+// this is an UNPUBLISHED API call - it may change in the future
+
+// GetApplicationTopology obtains the flow map of an application for a time range
+func (s *TopologyService) GetApplicationTopology(appID int, timeRangeType string, durationInMins int, startTime time.Time, endTime time.Time) (*Topology, error) {
+
+	url := fmt.Sprintf("controller/restui/applicationFlowMapUiService/application/%d?time-range=%s&mapId=-1&baselineId=-1&forceFetch=false",
+		appID, restuiTimeRange(timeRangeType, durationInMins, startTime, endTime))
+
+	var flowMap flowMapResponse
+	err := s.client.RestInternal("GET", url, &flowMap, nil)
+	if err != nil {
+		return nil, fmt.Errorf("Flow map API: %v -> %s", err, url)
+	}
+
+	start, end := metricTimeRange(timeRangeType, durationInMins, startTime, endTime, time.Now())
+	topology := &Topology{ApplicationID: appID, Start: start, End: end}
+	for _, node := range flowMap.Nodes {
+		topology.Nodes = append(topology.Nodes, &TopologyNode{
+			ID:            topologyNodeID(node.EntityType, node.IDNum),
+			EntityID:      node.IDNum,
+			Type:          topologyNodeType(node.EntityType),
+			Name:          node.Name,
+			TopologyStats: node.Stats.topology(),
+		})
+	}
+	for _, edge := range flowMap.Edges {
+		topology.Edges = append(topology.Edges, &TopologyEdge{
+			Source:        topologyNodeID(edge.SourceNodeDefinition.EntityType, edge.SourceNodeDefinition.EntityID),
+			Target:        topologyNodeID(edge.TargetNodeDefinition.EntityType, edge.TargetNodeDefinition.EntityID),
+			TopologyStats: edge.Stats.topology(),
+		})
+	}
+
+	sort.Slice(topology.Nodes, func(i, j int) bool { return topology.Nodes[i].ID < topology.Nodes[j].ID })
+	sort.Slice(topology.Edges, func(i, j int) bool {
+		if topology.Edges[i].Source != topology.Edges[j].Source {
+			return topology.Edges[i].Source < topology.Edges[j].Source
+		}
+		return topology.Edges[i].Target < topology.Edges[j].Target
+	})
+	return topology, nil
+}
+
+// DANGER ZONE END
@@ -0,0 +1,83 @@
+/*
+MIT License
+
+Copyright (c) 2026 Cisco Systems, Inc. and its affiliates
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+
+*/
+
+package appdrest
+
+import (
+	"bytes"
+	"flag"
+	"os"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "rewrite the golden files in testdata")
+
+// oddTopology has names with characters that need escaping in DOT and Mermaid
+func oddTopology() *Topology {
+	return &Topology{
+		ApplicationID: 7,
+		Nodes: []*TopologyNode{
+			{ID: "TIER:1", Type: TopologyTier, Name: `web "frontend" [eu]`},
+			{ID: "BACKEND:2", Type: TopologyBackend, Name: `jdbc:mysql://db|orders\shard#1`},
+			{ID: "REMOTE_APPLICATION:3", Type: TopologyRemoteApplication, Name: "`billing` <v2> & co\nline2 \\N"},
+		},
+		Edges: []*TopologyEdge{
+			{Source: "TIER:1", Target: "BACKEND:2", TopologyStats: TopologyStats{CallsPerMinute: 120.25, AverageResponseTime: 12.4, ErrorsPerMinute: 0.5}},
+			{Source: "TIER:1", Target: "REMOTE_APPLICATION:3", TopologyStats: TopologyStats{CallsPerMinute: 3}},
+		},
+	}
+}
+
+func checkGolden(t *testing.T, file string, got []byte) {
+	t.Helper()
+	if *update {
+		if err := os.WriteFile(file, got, 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	want, err := os.ReadFile(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Errorf("output differs from %s:\n%s", file, got)
+	}
+}
+
+func TestTopologyWriteDOT(t *testing.T) {
+	var buf bytes.Buffer
+	if err := oddTopology().WriteDOT(&buf); err != nil {
+		t.Fatal(err)
+	}
+	checkGolden(t, "testdata/topology.dot", buf.Bytes())
+}
+
+func TestTopologyWriteMermaid(t *testing.T) {
+	var buf bytes.Buffer
+	if err := oddTopology().WriteMermaid(&buf); err != nil {
+		t.Fatal(err)
+	}
+	checkGolden(t, "testdata/topology.mmd", buf.Bytes())
+}
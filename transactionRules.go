@@ -32,12 +32,14 @@ import (
 	"mime/multipart"
 )
 
+// Modified by 2026 Cisco Systems, Inc.
 type Scope struct {
-	Text             string `xml:",chardata"`
-	ScopeDescription string `xml:"scope-description,attr"`
-	ScopeName        string `xml:"scope-name,attr"`
-	ScopeType        string `xml:"scope-type,attr"`
-	ScopeVersion     string `xml:"scope-version,attr"`
+	Text             string   `xml:",chardata"`
+	ScopeDescription string   `xml:"scope-description,attr"`
+	ScopeName        string   `xml:"scope-name,attr"`
+	ScopeType        string   `xml:"scope-type,attr"`
+	ScopeVersion     string   `xml:"scope-version,attr"`
+	IncludedTiers    []string `xml:"included-tiers>tier-name,omitempty"` // tiers of a SELECTED_TIERS scope
 }
 
 type ScopeList struct {
@@ -134,6 +136,9 @@ type TxRuleUploadResult struct {
 type TransactionRulesService service
 
 // UploadTransactionRules - upload transaction detection rules for an application
+// Modified by 2026 Cisco Systems, Inc.
+//
+// Deprecated: errors are printed instead of returned, use ImportTransactionDetectionRules.
 func (s *TransactionRulesService) UploadTransactionRules(appNameOrId string, rules *MdsData) error {
 
 	rulesXml, err := xml.Marshal(rules)
@@ -176,6 +181,22 @@ func (s *TransactionRulesService) UploadTransactionRules(appNameOrId string, rul
 	return nil
 }
 
+// ImportTransactionDetectionRules uploads custom transaction detection rules for an application.
+// Unlike UploadTransactionRules it reports upload errors and rules rejected by the controller.
+// Added 2026 Cisco Systems, Inc.
+func (s *TransactionRulesService) ImportTransactionDetectionRules(appNameOrId string, rules *MdsData) error {
+
+	rulesXml, err := xml.Marshal(rules)
+	if err != nil {
+		return err
+	}
+
+	url := "/controller/transactiondetection/" + appNameOrId + "/custom"
+
+	_, err = s.client.uploadFile(url, "rules.xml", rulesXml)
+	return err
+}
+
 type TxRulesResponse struct {
 	RuleScopeSummaryMappings []TxRuleScopeSummaryMappings `json:"ruleScopeSummaryMappings"`
 }
@@ -299,3 +320,24 @@ func (s *TransactionRulesService) GetApplicationsScopes(appId int) (*ScopesRespo
 
 	return scopes, nil
 }
+
+// ExportTransactionDetectionRules - export custom transaction detection rules of an application
+// in the XML format accepted by ImportTransactionDetectionRules
+// Added 2026 Cisco Systems, Inc.
+func (s *TransactionRulesService) ExportTransactionDetectionRules(appNameOrId string) (*MdsData, error) {
+
+	url := "/controller/transactiondetection/" + appNameOrId + "/custom"
+
+	body, err := s.client.DoRawRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var rules MdsData
+	err = xml.Unmarshal(body, &rules)
+	if err != nil {
+		return nil, err
+	}
+
+	return &rules, nil
+}